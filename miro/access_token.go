@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 const (
 	// endpointOAUTHToken /oauth-token endpoint
 	endpointOAUTHToken = "oauth-token"
@@ -14,12 +16,17 @@ type AccessTokenService struct {
 
 // Get information about an access token, such as the token type, scopes, team, user, token creation date and time, and the user who created the token.
 func (a *AccessTokenService) Get() (*AccessToken, error) {
+	return a.GetWithContext(a.client.ctx)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (a *AccessTokenService) GetWithContext(ctx context.Context) (*AccessToken, error) {
 	response := &AccessToken{}
 
 	if url, err := constructURL(a.client.BaseURL, a.apiVersion, endpointOAUTHToken); err != nil {
 		return response, err
 	} else {
-		err = a.client.Get(a.client.ctx, url, response)
+		err = a.client.Get(ctx, url, response)
 		return response, err
 	}
 }
@@ -27,10 +34,15 @@ func (a *AccessTokenService) Get() (*AccessToken, error) {
 // Revoke Revoking an access token means that the access token will no longer work. When an access token is revoked,
 // the refresh token is also revoked and no longer valid. This does not uninstall the application for the user.
 func (a *AccessTokenService) Revoke(accessToken string) error {
+	return a.RevokeWithContext(a.client.ctx, accessToken)
+}
+
+// RevokeWithContext Revoke using the given context, which can be used to cancel the request or set a deadline.
+func (a *AccessTokenService) RevokeWithContext(ctx context.Context, accessToken string) error {
 	if url, err := constructURL(a.client.BaseURL, a.apiVersion, endpointOAUTH, "revoke"); err != nil {
 		return err
 	} else {
-		err = a.client.postNoContent(a.client.ctx, url, Parameter{
+		err = a.client.postNoContent(ctx, url, Parameter{
 			"access_token": accessToken,
 		})
 		return err
@@ -7,4 +7,15 @@ type AccessToken struct {
 	Scopes       []string        `json:"scopes"`
 	Organization BasicEntityInfo `json:"organization"`
 	User         BasicEntityInfo `json:"user"`
+	Extra        Extra           `json:"-"`
+}
+
+func (a *AccessToken) UnmarshalJSON(data []byte) error {
+	type accessToken AccessToken
+	return unmarshalWithExtra(data, (*accessToken)(a), &a.Extra)
+}
+
+func (a AccessToken) MarshalJSON() ([]byte, error) {
+	type accessToken AccessToken
+	return marshalWithExtra(accessToken(a), a.Extra)
 }
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type AppCardItemsService struct {
 	client      *Client
 	apiVersion  string
@@ -10,12 +12,17 @@ type AppCardItemsService struct {
 // Create an app card item on a board.
 // Required scope: boards:write | Rate limiting: Level 2
 func (a *AppCardItemsService) Create(boardID string, payload AppCardItemSet) (*AppCardItem, error) {
+	return a.CreateWithContext(a.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (a *AppCardItemsService) CreateWithContext(ctx context.Context, boardID string, payload AppCardItemSet) (*AppCardItem, error) {
 	response := &AppCardItem{}
 
 	if url, err := constructURL(a.client.BaseURL, a.apiVersion, a.resource, boardID, a.subResource); err != nil {
 		return response, err
 	} else {
-		err = a.client.Post(a.client.ctx, url, payload, response)
+		err = a.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -23,25 +30,35 @@ func (a *AppCardItemsService) Create(boardID string, payload AppCardItemSet) (*A
 // Get information for a specific app card item on a board.
 // Required scope: boards:read | Rate limiting: Level 1
 func (a *AppCardItemsService) Get(boardID, itemID string) (*AppCardItem, error) {
+	return a.GetWithContext(a.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (a *AppCardItemsService) GetWithContext(ctx context.Context, boardID, itemID string) (*AppCardItem, error) {
 	response := &AppCardItem{}
 
 	if url, err := constructURL(a.client.BaseURL, a.apiVersion, a.resource, boardID, a.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = a.client.Get(a.client.ctx, url, response)
+		err = a.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update an app card item on a board based on the data and style properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 2
-func (a *AppCardItemsService) Update(boardID, itemID string, payload AppCardItemSet) (*AppCardItem, error) {
+func (a *AppCardItemsService) Update(boardID, itemID string, payload AppCardItemSet, fields ...PatchField) (*AppCardItem, error) {
+	return a.UpdateWithContext(a.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (a *AppCardItemsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload AppCardItemSet, fields ...PatchField) (*AppCardItem, error) {
 	response := &AppCardItem{}
 
 	if url, err := constructURL(a.client.BaseURL, a.apiVersion, a.resource, boardID, a.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = a.client.Patch(a.client.ctx, url, payload, response)
+		err = a.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -49,9 +66,14 @@ func (a *AppCardItemsService) Update(boardID, itemID string, payload AppCardItem
 // Delete an app card item from a board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (a *AppCardItemsService) Delete(boardID, itemID string) error {
+	return a.DeleteWithContext(a.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (a *AppCardItemsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(a.client.BaseURL, a.apiVersion, a.resource, boardID, a.subResource, itemID); err != nil {
 		return err
 	} else {
-		return a.client.Delete(a.client.ctx, url)
+		return a.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
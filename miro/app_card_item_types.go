@@ -68,4 +68,15 @@ type AppCardItem struct {
 	Parent     *Parent         `json:"parent,omitempty"`
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
+	Extra      Extra           `json:"-"`
+}
+
+func (a *AppCardItem) UnmarshalJSON(data []byte) error {
+	type appCardItem AppCardItem
+	return unmarshalWithExtra(data, (*appCardItem)(a), &a.Extra)
+}
+
+func (a AppCardItem) MarshalJSON() ([]byte, error) {
+	type appCardItem AppCardItem
+	return marshalWithExtra(appCardItem(a), a.Extra)
 }
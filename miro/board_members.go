@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type BoardMembersService struct {
 	client      *Client
 	apiVersion  string
@@ -12,12 +14,17 @@ type BoardMembersService struct {
 // order to share the board with a user.
 // Required scope: boards:write | Rate limiting: Level 3
 func (b *BoardMembersService) ShareBoard(boardID string, payload ShareBoardInvitation) (*BoardInvitationResponse, error) {
+	return b.ShareBoardWithContext(b.client.ctx, boardID, payload)
+}
+
+// ShareBoardWithContext ShareBoard using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardMembersService) ShareBoardWithContext(ctx context.Context, boardID string, payload ShareBoardInvitation) (*BoardInvitationResponse, error) {
 	response := &BoardInvitationResponse{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID, b.subResource); err != nil {
 		return response, err
 	} else {
-		err = b.client.Post(b.client.ctx, url, payload, response)
+		err = b.client.Post(withRateLimitLevel(ctx, RateLimitLevel3), url, payload, response)
 		return response, err
 	}
 }
@@ -25,12 +32,17 @@ func (b *BoardMembersService) ShareBoard(boardID string, payload ShareBoardInvit
 // Get information for a board member.
 // Required scope: boards:read | Rate limiting: Level 1
 func (b *BoardMembersService) Get(boardID, itemID string) (*BoardMember, error) {
+	return b.GetWithContext(b.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardMembersService) GetWithContext(ctx context.Context, boardID, itemID string) (*BoardMember, error) {
 	response := &BoardMember{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID, b.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = b.client.Get(b.client.ctx, url, response)
+		err = b.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
@@ -39,30 +51,61 @@ func (b *BoardMembersService) Get(boardID, itemID string) (*BoardMember, error)
 // Required scope: boards:read | Rate limiting: Level 1
 // Search query params: BoardMemberSearchParams{}
 func (b *BoardMembersService) GetAll(boardID string, queryParams ...BoardMemberSearchParams) (*ListBoardMembers, error) {
+	return b.GetAllWithContext(b.client.ctx, boardID, queryParams...)
+}
+
+// GetAllWithContext GetAll using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardMembersService) GetAllWithContext(ctx context.Context, boardID string, queryParams ...BoardMemberSearchParams) (*ListBoardMembers, error) {
 	response := &ListBoardMembers{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID, b.subResource); err != nil {
 		return response, err
 	} else {
+		var searchParams []Parameter
 		if len(queryParams) > 0 {
-			err = b.client.Get(b.client.ctx, url, response, parseQueryTags(queryParams[0])...)
-		} else {
-			err = b.client.Get(b.client.ctx, url, response)
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
 		}
+		err = b.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response, searchParams...)
 
 		return response, err
 	}
 }
 
+// Iterate returns an iterator over all the members of a board, fetching the pages of results as they are needed.
+// Required scope: boards:read | Rate limiting: Level 1 (per page)
+func (b *BoardMembersService) Iterate(boardID string, queryParams ...BoardMemberSearchParams) *Iterator[*BoardMember] {
+	return b.IterateWithContext(b.client.ctx, boardID, queryParams...)
+}
+
+// IterateWithContext Iterate using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (b *BoardMembersService) IterateWithContext(ctx context.Context, boardID string, queryParams ...BoardMemberSearchParams) *Iterator[*BoardMember] {
+	url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID, b.subResource)
+	var searchParams []Parameter
+	if err == nil && len(queryParams) > 0 {
+		searchParams, err = encodeQueryTags(queryParams[0])
+	}
+
+	return newOffsetIterator(ctx, b.client, RateLimitLevel1, url, err, searchParams, func(list *ListBoardMembers) ([]*BoardMember, offsetPage) {
+		return list.Data, offsetPage{links: list.Links, offset: list.Offset, limit: list.Limit, size: list.Size, total: list.Total}
+	})
+}
+
 // Update the role of a board member.
 // Required scope: boards:write | Rate limiting: Level 2
 func (b *BoardMembersService) Update(boardID, itemID string, role Role) (*BoardMember, error) {
+	return b.UpdateWithContext(b.client.ctx, boardID, itemID, role)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardMembersService) UpdateWithContext(ctx context.Context, boardID, itemID string, role Role) (*BoardMember, error) {
 	response := &BoardMember{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID, b.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = b.client.Patch(b.client.ctx, url, RoleUpdate{Role: role}, response)
+		err = b.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, RoleUpdate{Role: role}, response)
 		return response, err
 	}
 }
@@ -70,9 +113,14 @@ func (b *BoardMembersService) Update(boardID, itemID string, role Role) (*BoardM
 // Delete Removes a board member from a board.
 // Required scope: boards:write | Rate limiting: Level 2
 func (b *BoardMembersService) Delete(boardID, itemID string) error {
+	return b.DeleteWithContext(b.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardMembersService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID, b.subResource, itemID); err != nil {
 		return err
 	} else {
-		return b.client.Delete(b.client.ctx, url)
+		return b.client.Delete(withRateLimitLevel(ctx, RateLimitLevel2), url)
 	}
 }
@@ -30,12 +30,45 @@ type BoardInvitationResponse struct {
 		Reason string `json:"reason,omitempty"`
 	} `json:"failed,omitempty"`
 	Successful string `json:"successful,omitempty"`
+	Extra      Extra  `json:"-"`
+}
+
+func (b *BoardInvitationResponse) UnmarshalJSON(data []byte) error {
+	type boardInvitationResponse BoardInvitationResponse
+	return unmarshalWithExtra(data, (*boardInvitationResponse)(b), &b.Extra)
+}
+
+func (b BoardInvitationResponse) MarshalJSON() ([]byte, error) {
+	type boardInvitationResponse BoardInvitationResponse
+	return marshalWithExtra(boardInvitationResponse(b), b.Extra)
 }
 
 type BoardMember struct {
 	BasicEntityInfo
 	Role  Role            `json:"role"`
 	Links PaginationLinks `json:"links,omitempty"`
+	Extra Extra           `json:"-"`
+}
+
+// boardMember BoardMember with the embedded BasicEntityInfo replaced by its fields
+type boardMember struct {
+	entityFields
+	Role  Role            `json:"role"`
+	Links PaginationLinks `json:"links,omitempty"`
+}
+
+func (b *BoardMember) UnmarshalJSON(data []byte) error {
+	var member boardMember
+	var extra Extra
+	if err := unmarshalWithExtra(data, &member, &extra); err != nil {
+		return err
+	}
+	*b = BoardMember{BasicEntityInfo: BasicEntityInfo(member.entityFields), Role: member.Role, Links: member.Links, Extra: extra}
+	return nil
+}
+
+func (b BoardMember) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(boardMember{entityFields(b.BasicEntityInfo), b.Role, b.Links}, b.Extra)
 }
 
 type ListBoardMembers struct {
@@ -46,6 +79,17 @@ type ListBoardMembers struct {
 	Limit  int              `json:"limit"`
 	Links  *PaginationLinks `json:"links"`
 	Type   string           `json:"type"`
+	Extra  Extra            `json:"-"`
+}
+
+func (l *ListBoardMembers) UnmarshalJSON(data []byte) error {
+	type listBoardMembers ListBoardMembers
+	return unmarshalWithExtra(data, (*listBoardMembers)(l), &l.Extra)
+}
+
+func (l ListBoardMembers) MarshalJSON() ([]byte, error) {
+	type listBoardMembers ListBoardMembers
+	return marshalWithExtra(listBoardMembers(l), l.Extra)
 }
 
 type BoardMemberSearchParams struct {
@@ -1,8 +1,6 @@
 package miro
 
-import (
-	"strings"
-)
+import "context"
 
 type BoardsService struct {
 	client     *Client
@@ -13,12 +11,17 @@ type BoardsService struct {
 // Create a board with the specified name and sharing policies.
 // Required scope: boards:write | Rate limiting: Level 3
 func (b *BoardsService) Create(payload SetBoard) (*Board, error) {
+	return b.CreateWithContext(b.client.ctx, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardsService) CreateWithContext(ctx context.Context, payload SetBoard) (*Board, error) {
 	response := &Board{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource); err != nil {
 		return response, err
 	} else {
-		err = b.client.Post(b.client.ctx, url, payload, response)
+		err = b.client.Post(withRateLimitLevel(ctx, RateLimitLevel3), url, payload, response)
 		return response, err
 	}
 }
@@ -26,12 +29,17 @@ func (b *BoardsService) Create(payload SetBoard) (*Board, error) {
 // Get information about a board.
 // Required scope: boards:read | Rate limiting: Level 1
 func (b *BoardsService) Get(boardID string) (*Board, error) {
+	return b.GetWithContext(b.client.ctx, boardID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardsService) GetWithContext(ctx context.Context, boardID string) (*Board, error) {
 	response := &Board{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID); err != nil {
 		return response, err
 	} else {
-		err = b.client.Get(b.client.ctx, url, response)
+		err = b.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
@@ -40,69 +48,149 @@ func (b *BoardsService) Get(boardID string) (*Board, error) {
 // Required scope: boards:read | Rate limiting: Level 1
 // Search query params: BoardSearchParams{}
 func (b *BoardsService) GetAll(queryParams ...BoardSearchParams) (*ListBoards, error) {
+	return b.GetAllWithContext(b.client.ctx, queryParams...)
+}
+
+// GetAllWithContext GetAll using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardsService) GetAllWithContext(ctx context.Context, queryParams ...BoardSearchParams) (*ListBoards, error) {
 	response := &ListBoards{client: b.client, firstResults: true}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource); err != nil {
 		return response, err
 	} else {
+		response.url = url
 		if len(queryParams) > 0 {
-			err = b.client.Get(b.client.ctx, url, response, parseQueryTags(queryParams[0])...)
-		} else {
-			err = b.client.Get(b.client.ctx, url, response)
+			if response.searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
 		}
+		err = b.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response, response.searchParams...)
 
 		return response, err
 	}
 }
 
 // GetNext iterator to get and return a pointer to the next set of search results, starting with the first set returned by the GetAll method
+// Required scope: boards:read | Rate limiting: Level 1
 func (l *ListBoards) GetNext() (*ListBoards, error) {
-	response := &ListBoards{client: l.client}
+	return l.GetNextWithContext(l.client.ctx)
+}
+
+// GetNextWithContext GetNext using the given context, which can be used to cancel the request or set a deadline.
+func (l *ListBoards) GetNextWithContext(ctx context.Context) (*ListBoards, error) {
+	response := &ListBoards{client: l.client, url: l.url, searchParams: l.searchParams}
 
 	if l.firstResults {
 		l.firstResults = false
 		return l, nil
 	}
 
-	var url string
-	if idx := strings.Index(l.Links.Next, "{"); idx != -1 {
-		url = l.Links.Next[:idx]
-	} else if l.Links.Next == "" {
+	url, err := nextPageURL(l.url, offsetPage{links: l.Links, offset: l.Offset, limit: l.Limit, size: l.Size, total: l.Total}, l.searchParams)
+	if err != nil {
+		return response, err
+	} else if url == "" {
 		return response, IteratorDone
-	} else {
-		url = l.Links.Next
 	}
 
-	err := l.client.Get(l.client.ctx, url, response)
-
-	l.Links.Next = response.Links.Next
+	if err = l.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response); err == nil {
+		// move on to the page just fetched, so the next call fetches the page after it
+		l.Links, l.Offset, l.Size, l.Total = response.Links, response.Offset, response.Size, response.Total
+	}
 
 	return response, err
 }
 
+// Iterate returns an iterator over all the boards that match the search criteria, fetching the pages of results as
+// they are needed.
+// Required scope: boards:read | Rate limiting: Level 1 (per page)
+func (b *BoardsService) Iterate(queryParams ...BoardSearchParams) *Iterator[*Board] {
+	return b.IterateWithContext(b.client.ctx, queryParams...)
+}
+
+// IterateWithContext Iterate using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (b *BoardsService) IterateWithContext(ctx context.Context, queryParams ...BoardSearchParams) *Iterator[*Board] {
+	url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource)
+	var searchParams []Parameter
+	if err == nil && len(queryParams) > 0 {
+		searchParams, err = encodeQueryTags(queryParams[0])
+	}
+
+	return newOffsetIterator(ctx, b.client, RateLimitLevel1, url, err, searchParams, func(list *ListBoards) ([]*Board, offsetPage) {
+		return list.Data, offsetPage{links: list.Links, offset: list.Offset, limit: list.Limit, size: list.Size, total: list.Total}
+	})
+}
+
+// FetchAll fetches all the boards that match the search criteria, fetching the first page, then the rest of the pages
+// concurrently. The boards are returned in order, and a board that moves from one page to the next while the pages are
+// being fetched is only returned once. If the options' MaxRequests isn't enough to fetch every page, the boards that
+// were fetched are returned along with ErrRequestBudgetExceeded.
+// Required scope: boards:read | Rate limiting: Level 1 (per page)
+func (b *BoardsService) FetchAll(options FetchOptions, queryParams ...BoardSearchParams) ([]*Board, error) {
+	return b.FetchAllWithContext(b.client.ctx, options, queryParams...)
+}
+
+// FetchAllWithContext FetchAll using the given context, which can be used to cancel the requests or set a deadline.
+func (b *BoardsService) FetchAllWithContext(ctx context.Context, options FetchOptions, queryParams ...BoardSearchParams) ([]*Board, error) {
+	url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource)
+	if err != nil {
+		return nil, err
+	}
+
+	searchParams := make(Parameter)
+	if len(queryParams) > 0 {
+		encoded, err := encodeQueryTags(queryParams[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, params := range encoded {
+			for key, value := range params {
+				searchParams[key] = value
+			}
+		}
+	}
+
+	return fetchOffsetPages(ctx, options, searchParams, func(ctx context.Context, params Parameter) ([]*Board, offsetPage, error) {
+		response := &ListBoards{}
+		err := b.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response, params)
+		return response.Data, offsetPage{offset: response.Offset, limit: response.Limit, size: response.Size, total: response.Total}, err
+	}, func(board *Board) string {
+		return board.ID
+	})
+}
+
 // Copy Creates a copy of an existing board. You can also update the name, description, sharing policy, and permissions
 // policy for the new board in the request body.
 // Required scope: boards:write | Rate limiting: Level 4
 func (b *BoardsService) Copy(payload SetBoard, copyFrom string) (*Board, error) {
+	return b.CopyWithContext(b.client.ctx, payload, copyFrom)
+}
+
+// CopyWithContext Copy using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardsService) CopyWithContext(ctx context.Context, payload SetBoard, copyFrom string) (*Board, error) {
 	response := &Board{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource); err != nil {
 		return response, err
 	} else {
-		err = b.client.Put(b.client.ctx, url, payload, response, Parameter{"copy_from": copyFrom})
+		err = b.client.Put(withRateLimitLevel(ctx, RateLimitLevel4), url, payload, response, Parameter{"copy_from": copyFrom})
 		return response, err
 	}
 }
 
 // Update a specific board.
 // Required scope: boards:write | Rate limiting: Level 2
-func (b *BoardsService) Update(boardID string, payload SetBoard) (*Board, error) {
+func (b *BoardsService) Update(boardID string, payload SetBoard, fields ...PatchField) (*Board, error) {
+	return b.UpdateWithContext(b.client.ctx, boardID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardsService) UpdateWithContext(ctx context.Context, boardID string, payload SetBoard, fields ...PatchField) (*Board, error) {
 	response := &Board{}
 
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID); err != nil {
 		return response, err
 	} else {
-		err = b.client.Patch(b.client.ctx, url, payload, response)
+		err = b.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -110,9 +198,14 @@ func (b *BoardsService) Update(boardID string, payload SetBoard) (*Board, error)
 // Delete a board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (b *BoardsService) Delete(boardID string) error {
+	return b.DeleteWithContext(b.client.ctx, boardID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (b *BoardsService) DeleteWithContext(ctx context.Context, boardID string) error {
 	if url, err := constructURL(b.client.BaseURL, b.apiVersion, b.resource, boardID); err != nil {
 		return err
 	} else {
-		return b.client.Delete(b.client.ctx, url)
+		return b.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
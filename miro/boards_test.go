@@ -1,7 +1,9 @@
 package miro
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"net/http"
@@ -452,3 +454,32 @@ func reverseSlice(s []*Board) {
 		s[i], s[j] = s[j], s[i]
 	}
 }
+
+func TestGetBoardWithContext(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "")
+	defer closeAPIServer()
+
+	release := make(chan struct{})
+	defer close(release)
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+
+	Convey("Given a board ID and a context with a deadline", t, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		Convey("When the Boards GetWithContext function is called and the API does not respond in time", func() {
+			start := time.Now()
+			_, err := client.Boards.GetWithContext(ctx, testBoardID)
+
+			Convey("Then the in-flight request is aborted and the context error is returned", func() {
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+				So(time.Since(start), ShouldBeLessThan, time.Second)
+			})
+		})
+	})
+}
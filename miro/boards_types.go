@@ -1,7 +1,6 @@
 package miro
 
 import (
-	"errors"
 	"time"
 )
 
@@ -35,11 +34,25 @@ type Board struct {
 	Links                 Links                  `json:"links"`
 	Type                  string                 `json:"type"`
 	Project               *Project               `json:"project,omitempty"`
+	Extra                 Extra                  `json:"-"`
+}
+
+func (b *Board) UnmarshalJSON(data []byte) error {
+	type board Board
+	return unmarshalWithExtra(data, (*board)(b), &b.Extra)
+}
+
+func (b Board) MarshalJSON() ([]byte, error) {
+	type board Board
+	return marshalWithExtra(board(b), b.Extra)
 }
 
 type ListBoards struct {
 	client       *Client
 	firstResults bool
+	// url & searchParams of the first page, used to build the URLs of the following pages
+	url          string
+	searchParams []Parameter
 	Data         []*Board         `json:"data"`
 	Total        int              `json:"total"`
 	Size         int              `json:"size"`
@@ -47,9 +60,18 @@ type ListBoards struct {
 	Limit        int              `json:"limit"`
 	Links        *PaginationLinks `json:"links"`
 	Type         string           `json:"type"`
+	Extra        Extra            `json:"-"`
 }
 
-var IteratorDone = errors.New("no more boards")
+func (l *ListBoards) UnmarshalJSON(data []byte) error {
+	type listBoards ListBoards
+	return unmarshalWithExtra(data, (*listBoards)(l), &l.Extra)
+}
+
+func (l ListBoards) MarshalJSON() ([]byte, error) {
+	type listBoards ListBoards
+	return marshalWithExtra(listBoards(l), l.Extra)
+}
 
 type (
 	Access       string
@@ -132,8 +154,19 @@ type Policy struct {
 }
 
 type Project struct {
-	ID   string `json:"id,omitempty"`
-	Type string `json:"type,omitempty"`
+	ID    string `json:"id,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Extra Extra  `json:"-"`
+}
+
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type project Project
+	return unmarshalWithExtra(data, (*project)(p), &p.Extra)
+}
+
+func (p Project) MarshalJSON() ([]byte, error) {
+	type project Project
+	return marshalWithExtra(project(p), p.Extra)
 }
 
 type Sort string
@@ -179,11 +212,43 @@ type BoardSearchParams struct {
 
 type CurrentUserMembership struct {
 	BasicEntityInfo
+	Role  string `json:"role"`
+	Extra Extra  `json:"-"`
+}
+
+// currentUserMembership CurrentUserMembership with the embedded BasicEntityInfo replaced by its fields
+type currentUserMembership struct {
+	entityFields
 	Role string `json:"role"`
 }
 
+func (c *CurrentUserMembership) UnmarshalJSON(data []byte) error {
+	var membership currentUserMembership
+	var extra Extra
+	if err := unmarshalWithExtra(data, &membership, &extra); err != nil {
+		return err
+	}
+	*c = CurrentUserMembership{BasicEntityInfo: BasicEntityInfo(membership.entityFields), Role: membership.Role, Extra: extra}
+	return nil
+}
+
+func (c CurrentUserMembership) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(currentUserMembership{entityFields(c.BasicEntityInfo), c.Role}, c.Extra)
+}
+
 type Picture struct {
 	ID       int64  `json:"id"`
 	ImageURL string `json:"imageURL"`
 	Type     string `json:"type"`
+	Extra    Extra  `json:"-"`
+}
+
+func (p *Picture) UnmarshalJSON(data []byte) error {
+	type picture Picture
+	return unmarshalWithExtra(data, (*picture)(p), &p.Extra)
+}
+
+func (p Picture) MarshalJSON() ([]byte, error) {
+	type picture Picture
+	return marshalWithExtra(picture(p), p.Extra)
 }
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type CardItemsService struct {
 	client      *Client
 	apiVersion  string
@@ -10,12 +12,17 @@ type CardItemsService struct {
 // Create a card item on a board
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *CardItemsService) Create(boardID string, payload SetCardItem) (*CardItem, error) {
+	return c.CreateWithContext(c.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (c *CardItemsService) CreateWithContext(ctx context.Context, boardID string, payload SetCardItem) (*CardItem, error) {
 	response := &CardItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
 	} else {
-		err = c.client.Post(c.client.ctx, url, payload, response)
+		err = c.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -23,25 +30,35 @@ func (c *CardItemsService) Create(boardID string, payload SetCardItem) (*CardIte
 // Get information for a specific card item on a board
 // Required scope: boards:read | Rate limiting: Level 1
 func (c *CardItemsService) Get(boardID, itemID string) (*CardItem, error) {
+	return c.GetWithContext(c.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (c *CardItemsService) GetWithContext(ctx context.Context, boardID, itemID string) (*CardItem, error) {
 	response := &CardItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Get(c.client.ctx, url, response)
+		err = c.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update a card item on a board based on the data and style properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 2
-func (c *CardItemsService) Update(boardID, itemID string, payload SetCardItem) (*CardItem, error) {
+func (c *CardItemsService) Update(boardID, itemID string, payload SetCardItem, fields ...PatchField) (*CardItem, error) {
+	return c.UpdateWithContext(c.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (c *CardItemsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload SetCardItem, fields ...PatchField) (*CardItem, error) {
 	response := &CardItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Patch(c.client.ctx, url, payload, response)
+		err = c.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -49,9 +66,14 @@ func (c *CardItemsService) Update(boardID, itemID string, payload SetCardItem) (
 // Delete a card item from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (c *CardItemsService) Delete(boardID, itemID string) error {
+	return c.DeleteWithContext(c.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (c *CardItemsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return err
 	} else {
-		return c.client.Delete(c.client.ctx, url)
+		return c.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
@@ -34,4 +34,15 @@ type CardItem struct {
 	Parent     *Parent         `json:"parent,omitempty"`
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
+	Extra      Extra           `json:"-"`
+}
+
+func (c *CardItem) UnmarshalJSON(data []byte) error {
+	type cardItem CardItem
+	return unmarshalWithExtra(data, (*cardItem)(c), &c.Extra)
+}
+
+func (c CardItem) MarshalJSON() ([]byte, error) {
+	type cardItem CardItem
+	return marshalWithExtra(cardItem(c), c.Extra)
 }
@@ -4,17 +4,48 @@ import "io"
 
 // BasicEntityInfo info type for different entities (i.e. users, teams & organizations)
 type BasicEntityInfo struct {
-	ID   string `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
-	Type string `json:"type,omitempty"`
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Extra Extra  `json:"-"`
 }
 
+func (b *BasicEntityInfo) UnmarshalJSON(data []byte) error {
+	type basicEntityInfo BasicEntityInfo
+	return unmarshalWithExtra(data, (*basicEntityInfo)(b), &b.Extra)
+}
+
+func (b BasicEntityInfo) MarshalJSON() ([]byte, error) {
+	type basicEntityInfo BasicEntityInfo
+	return marshalWithExtra(basicEntityInfo(b), b.Extra)
+}
+
+// entityFields BasicEntityInfo without its JSON methods, for the types embedding it, which would otherwise be
+// (un)marshalled by the promoted methods of BasicEntityInfo alone
+type entityFields BasicEntityInfo
+
 type PaginationLinks struct {
 	First string `json:"first,omitempty"`
 	Last  string `json:"last,omitempty"`
 	Next  string `json:"next,omitempty"`
 	Prev  string `json:"prev,omitempty"`
 	Self  string `json:"self,omitempty"`
+	Extra Extra  `json:"-"`
+}
+
+func (p *PaginationLinks) UnmarshalJSON(data []byte) error {
+	type paginationLinks PaginationLinks
+	return unmarshalWithExtra(data, (*paginationLinks)(p), &p.Extra)
+}
+
+func (p PaginationLinks) MarshalJSON() ([]byte, error) {
+	type paginationLinks PaginationLinks
+	return marshalWithExtra(paginationLinks(p), p.Extra)
+}
+
+// empty whether none of the links are set
+func (p PaginationLinks) empty() bool {
+	return p.First == "" && p.Last == "" && p.Next == "" && p.Prev == "" && p.Self == ""
 }
 
 type Geometry struct {
@@ -28,11 +59,33 @@ type Position struct {
 	RelativeTo string  `json:"relativeTo"`
 	X          float64 `json:"x,omitempty"`
 	Y          float64 `json:"y,omitempty"`
+	Extra      Extra   `json:"-"`
+}
+
+func (p *Position) UnmarshalJSON(data []byte) error {
+	type position Position
+	return unmarshalWithExtra(data, (*position)(p), &p.Extra)
+}
+
+func (p Position) MarshalJSON() ([]byte, error) {
+	type position Position
+	return marshalWithExtra(position(p), p.Extra)
 }
 
 type Parent struct {
 	ID    string          `json:"id,omitempty"`
 	Links PaginationLinks `json:"links,omitempty"`
+	Extra Extra           `json:"-"`
+}
+
+func (p *Parent) UnmarshalJSON(data []byte) error {
+	type parent Parent
+	return unmarshalWithExtra(data, (*parent)(p), &p.Extra)
+}
+
+func (p Parent) MarshalJSON() ([]byte, error) {
+	type parent Parent
+	return marshalWithExtra(parent(p), p.Extra)
 }
 
 type ParentSet struct {
@@ -111,6 +164,17 @@ type GeometrySet struct {
 type Links struct {
 	Related string `json:"related,omitempty"`
 	Self    string `json:"self,omitempty"`
+	Extra   Extra  `json:"-"`
+}
+
+func (l *Links) UnmarshalJSON(data []byte) error {
+	type links Links
+	return unmarshalWithExtra(data, (*links)(l), &l.Extra)
+}
+
+func (l Links) MarshalJSON() ([]byte, error) {
+	type links Links
+	return marshalWithExtra(links(l), l.Extra)
 }
 
 type UploadFileItem struct {
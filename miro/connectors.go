@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type ConnectorsService struct {
 	client      *Client
 	apiVersion  string
@@ -10,12 +12,17 @@ type ConnectorsService struct {
 // Create a connector on a board.
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *ConnectorsService) Create(boardID string, payload SetConnector) (*Connector, error) {
+	return c.CreateWithContext(c.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (c *ConnectorsService) CreateWithContext(ctx context.Context, boardID string, payload SetConnector) (*Connector, error) {
 	response := &Connector{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
 	} else {
-		err = c.client.Post(c.client.ctx, url, payload, response)
+		err = c.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -23,12 +30,17 @@ func (c *ConnectorsService) Create(boardID string, payload SetConnector) (*Conne
 // Get information for a specific connector on a board.
 // Required scope: boards:read | Rate limiting: Level 1
 func (c *ConnectorsService) Get(boardID, itemID string) (*Connector, error) {
+	return c.GetWithContext(c.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (c *ConnectorsService) GetWithContext(ctx context.Context, boardID, itemID string) (*Connector, error) {
 	response := &Connector{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Get(c.client.ctx, url, response)
+		err = c.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
@@ -46,30 +58,67 @@ func (c *ConnectorsService) Get(boardID, itemID string) (*Connector, error) {
 // Required scope: boards:read | Rate limiting: Level 2
 // Search query params: ConnectorsSearchParams{}
 func (c *ConnectorsService) GetAll(boardID string, queryParams ...ConnectorSearchParams) (*ListConnectors, error) {
+	return c.GetAllWithContext(c.client.ctx, boardID, queryParams...)
+}
+
+// GetAllWithContext GetAll using the given context, which can be used to cancel the request or set a deadline.
+func (c *ConnectorsService) GetAllWithContext(ctx context.Context, boardID string, queryParams ...ConnectorSearchParams) (*ListConnectors, error) {
 	response := &ListConnectors{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
 	} else {
+		var searchParams []Parameter
 		if len(queryParams) > 0 {
-			err = c.client.Get(c.client.ctx, url, response, parseQueryTags(queryParams[0])...)
-		} else {
-			err = c.client.Get(c.client.ctx, url, response)
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
 		}
+		err = c.client.Get(withRateLimitLevel(ctx, RateLimitLevel2), url, response, searchParams...)
 
 		return response, err
 	}
 }
 
+// Iterate returns an iterator over all the connectors on a board, fetching the pages of results as they are needed. The
+// Cursor of the search params is used as the starting point.
+// Required scope: boards:read | Rate limiting: Level 2 (per page)
+func (c *ConnectorsService) Iterate(boardID string, queryParams ...ConnectorSearchParams) *Iterator[Connector] {
+	return c.IterateWithContext(c.client.ctx, boardID, queryParams...)
+}
+
+// IterateWithContext Iterate using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (c *ConnectorsService) IterateWithContext(ctx context.Context, boardID string, queryParams ...ConnectorSearchParams) *Iterator[Connector] {
+	var params ConnectorSearchParams
+	if len(queryParams) > 0 {
+		params = queryParams[0]
+	}
+
+	iter := newIterator(ctx, func(ctx context.Context, cursor string) ([]Connector, string, error) {
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+		response, err := c.GetAllWithContext(ctx, boardID, params)
+		return response.Data, response.Cursor, err
+	})
+	iter.next = params.Cursor
+	return iter
+}
+
 // Update a connector on a board based on the data and style properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 2
-func (c *ConnectorsService) Update(boardID, itemID string, payload SetConnector) (*Connector, error) {
+func (c *ConnectorsService) Update(boardID, itemID string, payload SetConnector, fields ...PatchField) (*Connector, error) {
+	return c.UpdateWithContext(c.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (c *ConnectorsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload SetConnector, fields ...PatchField) (*Connector, error) {
 	response := &Connector{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Patch(c.client.ctx, url, payload, response)
+		err = c.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -77,9 +126,14 @@ func (c *ConnectorsService) Update(boardID, itemID string, payload SetConnector)
 // Delete the specified connector from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (c *ConnectorsService) Delete(boardID, itemID string) error {
+	return c.DeleteWithContext(c.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (c *ConnectorsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return err
 	} else {
-		return c.client.Delete(c.client.ctx, url)
+		return c.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
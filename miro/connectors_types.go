@@ -9,6 +9,17 @@ type ListConnectors struct {
 	Links  PaginationLinks `json:"links"`
 	Size   int             `json:"size"`
 	Total  int             `json:"total"`
+	Extra  Extra           `json:"-"`
+}
+
+func (l *ListConnectors) UnmarshalJSON(data []byte) error {
+	type listConnectors ListConnectors
+	return unmarshalWithExtra(data, (*listConnectors)(l), &l.Extra)
+}
+
+func (l ListConnectors) MarshalJSON() ([]byte, error) {
+	type listConnectors ListConnectors
+	return marshalWithExtra(listConnectors(l), l.Extra)
 }
 
 type ConnectorSearchParams struct {
@@ -36,6 +47,17 @@ type Connector struct {
 	StartItem   ConnectorItem   `json:"startItem"`
 	Style       ConnectorStyle  `json:"style"`
 	Type        string          `json:"type"`
+	Extra       Extra           `json:"-"`
+}
+
+func (c *Connector) UnmarshalJSON(data []byte) error {
+	type connector Connector
+	return unmarshalWithExtra(data, (*connector)(c), &c.Extra)
+}
+
+func (c Connector) MarshalJSON() ([]byte, error) {
+	type connector Connector
+	return marshalWithExtra(connector(c), c.Extra)
 }
 
 type SetConnector struct {
@@ -89,6 +111,17 @@ type ConnectorItem struct {
 	ID       string            `json:"id"`
 	Links    Links             `json:"links"`
 	Position ConnectorPosition `json:"position"`
+	Extra    Extra             `json:"-"`
+}
+
+func (c *ConnectorItem) UnmarshalJSON(data []byte) error {
+	type connectorItem ConnectorItem
+	return unmarshalWithExtra(data, (*connectorItem)(c), &c.Extra)
+}
+
+func (c ConnectorItem) MarshalJSON() ([]byte, error) {
+	type connectorItem ConnectorItem
+	return marshalWithExtra(connectorItem(c), c.Extra)
 }
 
 type (
@@ -1,8 +1,7 @@
 package miro
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"io"
 	"os"
 	"path"
@@ -18,12 +17,17 @@ type DocumentsService struct {
 // Create a document item on a board by specifying the URL where the document is hosted.
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *DocumentsService) Create(boardID string, payload DocumentItemSet) (*DocumentItem, error) {
+	return c.CreateWithContext(c.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) CreateWithContext(ctx context.Context, boardID string, payload DocumentItemSet) (*DocumentItem, error) {
 	response := &DocumentItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
 	} else {
-		err = c.client.Post(c.client.ctx, url, payload, response)
+		err = c.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -32,35 +36,38 @@ func (c *DocumentsService) Create(boardID string, payload DocumentItemSet) (*Doc
 // The maximum file size supported is 28.6 MB.
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *DocumentsService) Upload(boardID, filePath string, payload UploadFileItem) (*DocumentItem, error) {
-	response := &DocumentItem{}
+	return c.UploadWithContext(c.client.ctx, boardID, filePath, payload)
+}
 
+// UploadWithContext Upload using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) UploadWithContext(ctx context.Context, boardID, filePath string, payload UploadFileItem) (*DocumentItem, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return response, err
+		return &DocumentItem{}, err
 	}
-	fileName := path.Base(file.Name())
+	defer file.Close()
 
-	buf := &bytes.Buffer{}
-	if err := json.NewEncoder(buf).Encode(payload); err != nil {
-		return nil, err
-	}
+	return c.UploadFromReaderWithContext(ctx, boardID, path.Base(file.Name()), file, payload)
+}
 
-	multiParts := make(MultiParts)
-	multiParts["resource"] = MultiPart{
-		Reader:      io.Reader(file),
-		FileName:    fileName,
-		ContentType: "application/octet-stream",
-	}
-	multiParts["data"] = MultiPart{
-		Reader:      buf,
-		FileName:    fileName,
-		ContentType: "application/json",
-	}
+// UploadFromReader upload a document item, streaming the file from the reader rather than holding it in memory. The
+// content type of the file is detected from its contents. Use WithUploadProgress to report the progress of the upload.
+// The maximum file size supported is 28.6 MB.
+// Required scope: boards:write | Rate limiting: Level 2
+func (c *DocumentsService) UploadFromReader(boardID, fileName string, reader io.Reader, payload UploadFileItem) (*DocumentItem, error) {
+	return c.UploadFromReaderWithContext(c.client.ctx, boardID, fileName, reader, payload)
+}
+
+// UploadFromReaderWithContext UploadFromReader using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) UploadFromReaderWithContext(ctx context.Context, boardID, fileName string, reader io.Reader, payload UploadFileItem) (*DocumentItem, error) {
+	response := &DocumentItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
+	} else if multiParts, err := c.client.newUploadParts(ctx, fileName, reader, payload); err != nil {
+		return response, err
 	} else {
-		err = c.client.PostMultipart(c.client.ctx, url, multiParts, response)
+		err = c.client.PostMultipart(withRateLimitLevel(ctx, RateLimitLevel2), url, multiParts, response)
 		return response, err
 	}
 }
@@ -68,25 +75,35 @@ func (c *DocumentsService) Upload(boardID, filePath string, payload UploadFileIt
 // Get information for a specific document item on a board.
 // Required scope: boards:read | Rate limiting: Level 1
 func (c *DocumentsService) Get(boardID, itemID string) (*DocumentItem, error) {
+	return c.GetWithContext(c.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) GetWithContext(ctx context.Context, boardID, itemID string) (*DocumentItem, error) {
 	response := &DocumentItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Get(c.client.ctx, url, response)
+		err = c.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update a document item on a board.
 // Required scope: boards:write | Rate limiting: Level 2
-func (c *DocumentsService) Update(boardID, itemID string, payload DocumentItemSet) (*DocumentItem, error) {
+func (c *DocumentsService) Update(boardID, itemID string, payload DocumentItemSet, fields ...PatchField) (*DocumentItem, error) {
+	return c.UpdateWithContext(c.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload DocumentItemSet, fields ...PatchField) (*DocumentItem, error) {
 	response := &DocumentItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Patch(c.client.ctx, url, payload, response)
+		err = c.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -94,35 +111,37 @@ func (c *DocumentsService) Update(boardID, itemID string, payload DocumentItemSe
 // UpdateFromFile update document item using a file from a device.
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *DocumentsService) UpdateFromFile(boardID, itemID, filePath string, payload UploadFileItem) (*DocumentItem, error) {
-	response := &DocumentItem{}
+	return c.UpdateFromFileWithContext(c.client.ctx, boardID, itemID, filePath, payload)
+}
 
+// UpdateFromFileWithContext UpdateFromFile using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) UpdateFromFileWithContext(ctx context.Context, boardID, itemID, filePath string, payload UploadFileItem) (*DocumentItem, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return response, err
+		return &DocumentItem{}, err
 	}
-	fileName := path.Base(file.Name())
+	defer file.Close()
 
-	buf := &bytes.Buffer{}
-	if err := json.NewEncoder(buf).Encode(payload); err != nil {
-		return nil, err
-	}
+	return c.UpdateFromReaderWithContext(ctx, boardID, itemID, path.Base(file.Name()), file, payload)
+}
 
-	multiParts := make(MultiParts)
-	multiParts["resource"] = MultiPart{
-		Reader:      io.Reader(file),
-		FileName:    fileName,
-		ContentType: "application/octet-stream",
-	}
-	multiParts["data"] = MultiPart{
-		Reader:      buf,
-		FileName:    fileName,
-		ContentType: "application/json",
-	}
+// UpdateFromReader update a document item, streaming the file from the reader rather than holding it in memory. The
+// content type of the file is detected from its contents. Use WithUploadProgress to report the progress of the upload.
+// Required scope: boards:write | Rate limiting: Level 2
+func (c *DocumentsService) UpdateFromReader(boardID, itemID, fileName string, reader io.Reader, payload UploadFileItem) (*DocumentItem, error) {
+	return c.UpdateFromReaderWithContext(c.client.ctx, boardID, itemID, fileName, reader, payload)
+}
+
+// UpdateFromReaderWithContext UpdateFromReader using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) UpdateFromReaderWithContext(ctx context.Context, boardID, itemID, fileName string, reader io.Reader, payload UploadFileItem) (*DocumentItem, error) {
+	response := &DocumentItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
+	} else if multiParts, err := c.client.newUploadParts(ctx, fileName, reader, payload); err != nil {
+		return response, err
 	} else {
-		err = c.client.PatchMultipart(c.client.ctx, url, multiParts, response)
+		err = c.client.PatchMultipart(withRateLimitLevel(ctx, RateLimitLevel2), url, multiParts, response)
 		return response, err
 	}
 }
@@ -130,9 +149,14 @@ func (c *DocumentsService) UpdateFromFile(boardID, itemID, filePath string, payl
 // Delete a document item from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (c *DocumentsService) Delete(boardID, itemID string) error {
+	return c.DeleteWithContext(c.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (c *DocumentsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return err
 	} else {
-		return c.client.Delete(c.client.ctx, url)
+		return c.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
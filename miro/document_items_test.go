@@ -5,6 +5,7 @@ import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -234,3 +235,41 @@ func TestDeleteDocumentItem(t *testing.T) {
 		})
 	})
 }
+
+func TestUpdateDocumentItemFromReader(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "documents")
+	defer closeAPIServer()
+
+	fileContents := []byte("%PDF-1.7\n" + strings.Repeat("gopher ", 100))
+
+	var receivedRequest *http.Request
+	var receivedContentType string
+	mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, testItemID), func(w http.ResponseWriter, r *http.Request) {
+		r.ParseMultipartForm(32 << 20)
+		file, header, _ := r.FormFile("resource")
+		defer file.Close()
+		receivedContentType = header.Header.Get("Content-Type")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DocumentItem{ID: testItemID})
+		receivedRequest = r
+	})
+
+	Convey("Given a board ID, an item ID and a reader", t, func() {
+		Convey("When the UpdateFromReader method is called", func() {
+			results, err := client.DocumentItems.UpdateFromReader(testBoardID, testItemID, "plan.pdf", strings.NewReader(string(fileContents)), UploadFileItem{Title: "A test upload"})
+
+			Convey("Then the item is updated with the detected content type", func() {
+				So(err, ShouldBeNil)
+				So(results.ID, ShouldEqual, testItemID)
+				So(receivedContentType, ShouldEqual, "application/pdf")
+
+				Convey("And the request contains the expected headers and parameters", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodPatch)
+					So(receivedRequest.Header.Get("Authorization"), ShouldEqual, fmt.Sprintf("Bearer %s", testToken))
+				})
+			})
+		})
+	})
+}
@@ -18,6 +18,17 @@ type DocumentItemData struct {
 	DocumentURL string `json:"documentUrl"`
 	// Title URL where the document is hosted. (required)
 	Title string `json:"title,omitempty"`
+	Extra Extra  `json:"-"`
+}
+
+func (d *DocumentItemData) UnmarshalJSON(data []byte) error {
+	type documentItemData DocumentItemData
+	return unmarshalWithExtra(data, (*documentItemData)(d), &d.Extra)
+}
+
+func (d DocumentItemData) MarshalJSON() ([]byte, error) {
+	type documentItemData DocumentItemData
+	return marshalWithExtra(documentItemData(d), d.Extra)
 }
 
 type DocumentItem struct {
@@ -32,4 +43,15 @@ type DocumentItem struct {
 	Parent     *Parent          `json:"parent,omitempty"`
 	Links      Links            `json:"links"`
 	Type       string           `json:"type"`
+	Extra      Extra            `json:"-"`
+}
+
+func (d *DocumentItem) UnmarshalJSON(data []byte) error {
+	type documentItem DocumentItem
+	return unmarshalWithExtra(data, (*documentItem)(d), &d.Extra)
+}
+
+func (d DocumentItem) MarshalJSON() ([]byte, error) {
+	type documentItem DocumentItem
+	return marshalWithExtra(documentItem(d), d.Extra)
 }
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type EmbedItemsService struct {
 	client      *Client
 	apiVersion  string
@@ -10,12 +12,17 @@ type EmbedItemsService struct {
 // Create an embed item on a board
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *EmbedItemsService) Create(boardID string, payload SetEmbedItem) (*EmbedItem, error) {
+	return c.CreateWithContext(c.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (c *EmbedItemsService) CreateWithContext(ctx context.Context, boardID string, payload SetEmbedItem) (*EmbedItem, error) {
 	response := &EmbedItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
 	} else {
-		err = c.client.Post(c.client.ctx, url, payload, response)
+		err = c.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -23,25 +30,35 @@ func (c *EmbedItemsService) Create(boardID string, payload SetEmbedItem) (*Embed
 // Get information for a specific embed item on a board
 // Required scope: boards:read | Rate limiting: Level 1
 func (c *EmbedItemsService) Get(boardID, itemID string) (*EmbedItem, error) {
+	return c.GetWithContext(c.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (c *EmbedItemsService) GetWithContext(ctx context.Context, boardID, itemID string) (*EmbedItem, error) {
 	response := &EmbedItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Get(c.client.ctx, url, response)
+		err = c.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update an embed item on a board based on the data and style properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 2
-func (c *EmbedItemsService) Update(boardID, itemID string, payload SetEmbedItem) (*EmbedItem, error) {
+func (c *EmbedItemsService) Update(boardID, itemID string, payload SetEmbedItem, fields ...PatchField) (*EmbedItem, error) {
+	return c.UpdateWithContext(c.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (c *EmbedItemsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload SetEmbedItem, fields ...PatchField) (*EmbedItem, error) {
 	response := &EmbedItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Patch(c.client.ctx, url, payload, response)
+		err = c.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -49,9 +66,14 @@ func (c *EmbedItemsService) Update(boardID, itemID string, payload SetEmbedItem)
 // Delete an embed item from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (c *EmbedItemsService) Delete(boardID, itemID string) error {
+	return c.DeleteWithContext(c.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (c *EmbedItemsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return err
 	} else {
-		return c.client.Delete(c.client.ctx, url)
+		return c.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
@@ -12,6 +12,17 @@ type EmbedItemData struct {
 	ProviderUrl  string `json:"providerUrl"`
 	Title        string `json:"title"`
 	Url          string `json:"url"`
+	Extra        Extra  `json:"-"`
+}
+
+func (e *EmbedItemData) UnmarshalJSON(data []byte) error {
+	type embedItemData EmbedItemData
+	return unmarshalWithExtra(data, (*embedItemData)(e), &e.Extra)
+}
+
+func (e EmbedItemData) MarshalJSON() ([]byte, error) {
+	type embedItemData EmbedItemData
+	return marshalWithExtra(embedItemData(e), e.Extra)
 }
 
 type EmbedItem struct {
@@ -26,6 +37,17 @@ type EmbedItem struct {
 	Parent     Parent          `json:"parent"`
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
+	Extra      Extra           `json:"-"`
+}
+
+func (e *EmbedItem) UnmarshalJSON(data []byte) error {
+	type embedItem EmbedItem
+	return unmarshalWithExtra(data, (*embedItem)(e), &e.Extra)
+}
+
+func (e EmbedItem) MarshalJSON() ([]byte, error) {
+	type embedItem EmbedItem
+	return marshalWithExtra(embedItem(e), e.Extra)
 }
 
 type Mode string
@@ -0,0 +1,124 @@
+package miro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	headerRequestID = "X-Request-Id"
+	// maxErrorBodySize the maximum number of bytes read from an error response body
+	maxErrorBodySize = 1 << 20
+)
+
+type Field struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type Context struct {
+	Fields []Field `json:"fields"`
+}
+
+// ResponseError the error returned for any non-2xx response from the MIRO API. Use errors.As to inspect it, or one of
+// the IsNotFound, IsRateLimited, IsUnauthorized, IsValidation & IsRetryable helpers.
+type ResponseError struct {
+	// Status code of the error
+	Status int `json:"status"`
+	// Code of the error
+	Code string `json:"code"`
+	// Context details of the error
+	Context Context `json:"context"`
+	// Description of the error
+	Message string `json:"message"`
+	// Type of the error
+	Type string `json:"type"`
+	// StatusCode HTTP status code of the response
+	StatusCode int `json:"-"`
+	// RequestID MIRO's ID of the request, useful when raising a support ticket
+	RequestID string `json:"-"`
+	// Body the raw response body
+	Body []byte `json:"-"`
+}
+
+func (e *ResponseError) Error() string {
+	if e.Message == "" && e.Code == "" {
+		return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+	}
+
+	var errorDetails string
+	for _, field := range e.Context.Fields {
+		errorDetails += fmt.Sprintf("\n  %s: %s", field.Field, field.Message)
+	}
+
+	return fmt.Sprintf("unexpected status code: %d, message: %s (%s)%s", e.StatusCode, e.Message, e.Code, errorDetails)
+}
+
+// newResponseError construct a ResponseError from an unexpected response. Non-JSON response bodies still result in
+// a ResponseError, with only the status code, request ID and raw body set.
+func newResponseError(resp *http.Response) error {
+	respErr := &ResponseError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(headerRequestID),
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil {
+		return respErr
+	}
+	respErr.Body = body
+
+	if err := json.Unmarshal(body, respErr); err != nil {
+		respErr.Status, respErr.Code, respErr.Message, respErr.Type = 0, "", "", ""
+		respErr.Context = Context{}
+	}
+	if respErr.Status == 0 {
+		respErr.Status = resp.StatusCode
+	}
+
+	return respErr
+}
+
+// IsNotFound reports whether the error is a 404 (not found) response error
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsRateLimited reports whether the error is a 429 (too many requests) response error
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether the error is a 401 (unauthorized) response error
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsValidation reports whether the error is a response error caused by invalid input, i.e. a 400 (bad request) or
+// 422 (unprocessable entity), or any response error with field errors
+func IsValidation(err error) bool {
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	return respErr.StatusCode == http.StatusBadRequest ||
+		respErr.StatusCode == http.StatusUnprocessableEntity ||
+		len(respErr.Context.Fields) > 0
+}
+
+// IsRetryable reports whether the error is a response error that is worth retrying, i.e. a 429 or 5xx
+func IsRetryable(err error) bool {
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	return respErr.StatusCode == http.StatusTooManyRequests || respErr.StatusCode >= http.StatusInternalServerError
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var respErr *ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == statusCode
+}
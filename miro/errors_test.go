@@ -0,0 +1,112 @@
+package miro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"testing"
+)
+
+func TestResponseError(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, "", "")
+	defer closeAPIServer()
+
+	mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, "validation"), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "req-1")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ResponseError{
+			Status:  http.StatusBadRequest,
+			Code:    "2.0703",
+			Message: "Invalid parameters",
+			Context: Context{Fields: []Field{{Field: "name", Message: "must not be blank"}}},
+			Type:    "error",
+		})
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, "gateway"), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>Bad Gateway</html>"))
+	})
+
+	Convey("Given the MIRO API returns an error", t, func() {
+		Convey("When the error response has a JSON body", func() {
+			_, err := client.Boards.Get("validation")
+
+			Convey("Then a ResponseError with the decoded details is returned", func() {
+				var respErr *ResponseError
+				So(errors.As(err, &respErr), ShouldBeTrue)
+				So(respErr.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(respErr.Code, ShouldEqual, "2.0703")
+				So(respErr.RequestID, ShouldEqual, "req-1")
+				So(respErr.Context.Fields, ShouldResemble, []Field{{Field: "name", Message: "must not be blank"}})
+				So(string(respErr.Body), ShouldContainSubstring, "Invalid parameters")
+				So(err.Error(), ShouldEqual, "unexpected status code: 400, message: Invalid parameters (2.0703)\n  name: must not be blank")
+
+				Convey("And the predicates match the error", func() {
+					So(IsValidation(err), ShouldBeTrue)
+					So(IsNotFound(err), ShouldBeFalse)
+					So(IsRetryable(err), ShouldBeFalse)
+				})
+			})
+		})
+
+		Convey("When the error response has a non-JSON body", func() {
+			_, err := client.Boards.Get("gateway")
+
+			Convey("Then a ResponseError with the status code and raw body is returned", func() {
+				var respErr *ResponseError
+				So(errors.As(err, &respErr), ShouldBeTrue)
+				So(respErr.StatusCode, ShouldEqual, http.StatusBadGateway)
+				So(respErr.Status, ShouldEqual, http.StatusBadGateway)
+				So(string(respErr.Body), ShouldEqual, "<html>Bad Gateway</html>")
+				So(err.Error(), ShouldEqual, "unexpected status code: 502")
+
+				Convey("And the predicates match the error", func() {
+					So(IsRetryable(err), ShouldBeTrue)
+					So(IsValidation(err), ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
+
+func TestResponseErrorPredicates(t *testing.T) {
+	tests := []struct {
+		statusCode   int
+		notFound     bool
+		rateLimited  bool
+		unauthorized bool
+		retryable    bool
+	}{
+		{statusCode: http.StatusNotFound, notFound: true},
+		{statusCode: http.StatusTooManyRequests, rateLimited: true, retryable: true},
+		{statusCode: http.StatusUnauthorized, unauthorized: true},
+		{statusCode: http.StatusServiceUnavailable, retryable: true},
+	}
+
+	Convey("Given a wrapped response error", t, func() {
+		for _, test := range tests {
+			err := fmt.Errorf("wrapped: %w", &ResponseError{StatusCode: test.statusCode})
+
+			Convey(fmt.Sprintf("When the status code is %d", test.statusCode), func() {
+				Convey("Then the predicates report the expected results", func() {
+					So(IsNotFound(err), ShouldEqual, test.notFound)
+					So(IsRateLimited(err), ShouldEqual, test.rateLimited)
+					So(IsUnauthorized(err), ShouldEqual, test.unauthorized)
+					So(IsRetryable(err), ShouldEqual, test.retryable)
+				})
+			})
+		}
+
+		Convey("When the error is not a response error", func() {
+			err := errors.New("not found")
+
+			Convey("Then none of the predicates match", func() {
+				So(IsNotFound(err), ShouldBeFalse)
+				So(IsRetryable(err), ShouldBeFalse)
+				So(IsValidation(err), ShouldBeFalse)
+			})
+		})
+	})
+}
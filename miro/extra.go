@@ -0,0 +1,265 @@
+package miro
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Extra the fields of a response that this package doesn't recognise, keyed by their JSON names. They are kept so that
+// they are sent again when the value is marshalled, e.g. when restoring a snapshot of a board, so fields added to the
+// MIRO API since this package was released aren't lost.
+//
+// The types that are also sent as (part of) a payload, such as item data, styles & geometries, have no Extra of their
+// own, so that adding one doesn't break the composite literals building them. Their unknown fields are kept in the Extra
+// of the response they are part of instead, keyed by their path, e.g. "style.newThing" or "captions.0.newThing".
+type Extra map[string]json.RawMessage
+
+// knownFields the JSON names (in lower case, as encoding/json matches names ignoring case) of the fields of each type,
+// with the type of each field
+var knownFields sync.Map
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unmarshalWithExtra decode data into v, keeping any fields v doesn't have in extra, along with those of the nested
+// objects that have no Extra of their own. v must be a pointer to a type without an UnmarshalJSON method, i.e. a type
+// defined from the type being decoded so its methods aren't called again.
+func unmarshalWithExtra(data []byte, v interface{}, extra *Extra) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	*extra = nil
+	return collectExtra(data, reflect.TypeOf(v).Elem(), "", extra)
+}
+
+// collectExtra add the fields of the object in data that t doesn't have to extra, prefixing their names with the path
+// of the object
+func collectExtra(data []byte, t reflect.Type, path string, extra *Extra) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	known := jsonFieldNames(t)
+	for name, value := range fields {
+		fieldType, ok := known[strings.ToLower(name)]
+		if !ok {
+			if *extra == nil {
+				*extra = make(Extra)
+			}
+			(*extra)[path+name] = value
+			continue
+		}
+		if err := collectNestedExtra(value, fieldType, path+name+".", extra); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectNestedExtra collect the unknown fields of a nested object (or of each object in a nested array) whose type
+// doesn't keep them itself
+func collectNestedExtra(data []byte, t reflect.Type, path string, extra *Extra) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+
+	switch {
+	case t.Kind() == reflect.Struct && bytes.HasPrefix(data, []byte("{")):
+		return collectExtra(data, t, path, extra)
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && bytes.HasPrefix(data, []byte("[")):
+		var elems []json.RawMessage
+		if err := json.Unmarshal(data, &elems); err != nil {
+			return err
+		}
+		for i, elem := range elems {
+			if err := collectNestedExtra(elem, t.Elem(), path+strconv.Itoa(i)+".", extra); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// marshalWithExtra encode v, adding the fields in extra that v doesn't have, in order of name, and putting those with
+// the path of a nested object back into it. v must be a type without a MarshalJSON method, see unmarshalWithExtra.
+func marshalWithExtra(v interface{}, extra Extra) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	return addExtra(data, jsonFieldNames(reflect.TypeOf(v)), extra)
+}
+
+// addExtra add the fields in extra to the encoded object, skipping those the object's type has. Fields whose path
+// starts with the name of one of the object's fields are added to that field's value.
+func addExtra(data []byte, known map[string]reflect.Type, extra Extra) ([]byte, error) {
+	var names []string
+	nested := make(map[string]Extra)
+	for name, value := range extra {
+		if _, ok := known[strings.ToLower(name)]; ok {
+			continue
+		}
+		if field, rest, found := strings.Cut(name, "."); found {
+			if _, ok := known[strings.ToLower(field)]; ok {
+				if nested[strings.ToLower(field)] == nil {
+					nested[strings.ToLower(field)] = make(Extra)
+				}
+				nested[strings.ToLower(field)][rest] = value
+				continue
+			}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(nested) > 0 {
+		var err error
+		if data, err = addNestedExtra(data, known, nested); err != nil {
+			return nil, err
+		}
+	}
+	if len(names) == 0 {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for i, name := range names {
+		if i > 0 || len(data) > 2 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(extra[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// addNestedExtra add the fields in nested (keyed by the lower case name of the field holding them) to the values of the
+// object's fields, keeping the order of the fields
+func addNestedExtra(data []byte, known map[string]reflect.Type, nested map[string]Extra) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		name := token.(string)
+		if extra, ok := nested[strings.ToLower(name)]; ok {
+			if value, err = addExtraAt(value, known[strings.ToLower(name)], extra); err != nil {
+				return nil, err
+			}
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// addExtraAt add the fields in extra to the encoded value of a nested object, or to the objects of a nested array by
+// their index
+func addExtraAt(data []byte, t reflect.Type, extra Extra) ([]byte, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t.Kind() == reflect.Struct && bytes.HasPrefix(data, []byte("{")):
+		return addExtra(data, jsonFieldNames(t), extra)
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && bytes.HasPrefix(data, []byte("[")):
+		var elems []json.RawMessage
+		if err := json.Unmarshal(data, &elems); err != nil {
+			return nil, err
+		}
+		byIndex := make(map[int]Extra)
+		for path, value := range extra {
+			index, rest, _ := strings.Cut(path, ".")
+			if i, err := strconv.Atoi(index); err == nil && i >= 0 && i < len(elems) && rest != "" {
+				if byIndex[i] == nil {
+					byIndex[i] = make(Extra)
+				}
+				byIndex[i][rest] = value
+			}
+		}
+		for i, elemExtra := range byIndex {
+			elem, err := addExtraAt(elems[i], t.Elem(), elemExtra)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = elem
+		}
+		return json.Marshal(elems)
+	}
+	// the value is no longer an object (or array), so there is nowhere to put the fields
+	return data, nil
+}
+
+// jsonFieldNames the lower case JSON names of the fields of a struct type, including those of embedded structs, with
+// the type of each field
+func jsonFieldNames(t reflect.Type) map[string]reflect.Type {
+	if names, ok := knownFields.Load(t); ok {
+		return names.(map[string]reflect.Type)
+	}
+
+	names := make(map[string]reflect.Type)
+	addJSONFieldNames(t, names)
+	knownFields.Store(t, names)
+	return names
+}
+
+func addJSONFieldNames(t reflect.Type, names map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			addJSONFieldNames(fieldType, names)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		names[strings.ToLower(name)] = field.Type
+	}
+}
@@ -0,0 +1,166 @@
+package miro
+
+import (
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"testing"
+)
+
+func TestExtraFields(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "sticky_notes")
+	defer closeAPIServer()
+
+	mux.HandleFunc(testResourcePath+"/"+testItemID, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"id": "` + testItemID + `",
+			"type": "sticky_note",
+			"data": {"content": "Idea", "shape": "square", "emoji": {"name": "rocket"}},
+			"isSupportedByMiroGopher": false,
+			"experimentalFlags": ["a", "b"]
+		}`))
+	})
+
+	Convey("Given a response with fields this package doesn't know about", t, func() {
+		Convey("When it is decoded", func() {
+			note, err := client.StickyNotes.Get(testBoardID, testItemID)
+
+			Convey("Then the unknown fields are kept, including those of nested data", func() {
+				So(err, ShouldBeNil)
+				So(note.Data.Content, ShouldEqual, "Idea")
+				So(note.Extra, ShouldResemble, Extra{
+					"isSupportedByMiroGopher": json.RawMessage(`false`),
+					"experimentalFlags":       json.RawMessage(`["a", "b"]`),
+					"data.emoji":              json.RawMessage(`{"name": "rocket"}`),
+				})
+			})
+
+			Convey("And they are sent again when the value is marshalled", func() {
+				data, err := json.Marshal(note)
+				So(err, ShouldBeNil)
+
+				var roundTrip map[string]interface{}
+				So(json.Unmarshal(data, &roundTrip), ShouldBeNil)
+				So(roundTrip["experimentalFlags"], ShouldResemble, []interface{}{"a", "b"})
+				So(roundTrip["isSupportedByMiroGopher"], ShouldEqual, false)
+				So(roundTrip["data"].(map[string]interface{})["emoji"], ShouldResemble, map[string]interface{}{"name": "rocket"})
+				So(roundTrip["id"], ShouldEqual, testItemID)
+			})
+		})
+	})
+
+	Convey("Given a value with extra fields that clash with its own fields", t, func() {
+		tag := Tag{ID: "1", Title: "urgent", Extra: Extra{"title": json.RawMessage(`"stale"`), "color": json.RawMessage(`"red"`)}}
+
+		Convey("When it is marshalled", func() {
+			data, err := json.Marshal(tag)
+
+			Convey("Then the value's own fields win", func() {
+				So(err, ShouldBeNil)
+				var roundTrip Tag
+				So(json.Unmarshal(data, &roundTrip), ShouldBeNil)
+				So(roundTrip.Title, ShouldEqual, "urgent")
+				So(roundTrip.Extra, ShouldResemble, Extra{"color": json.RawMessage(`"red"`)})
+			})
+		})
+	})
+
+	Convey("Given a list of items with unknown fields", t, func() {
+		data := []byte(`{"data": [{"id": "1", "type": "card", "isLocked": true}], "cursor": "", "nextPageHint": 2}`)
+
+		Convey("When it is decoded and marshalled again", func() {
+			var list ListItems
+			So(json.Unmarshal(data, &list), ShouldBeNil)
+			roundTrip, err := json.Marshal(list)
+			So(err, ShouldBeNil)
+
+			Convey("Then the unknown fields of the list & its items are kept", func() {
+				So(list.Extra, ShouldResemble, Extra{"nextPageHint": json.RawMessage(`2`)})
+				So(list.Data[0].Extra, ShouldResemble, Extra{"isLocked": json.RawMessage(`true`)})
+				So(string(roundTrip), ShouldContainSubstring, `"isLocked":true`)
+				So(string(roundTrip), ShouldContainSubstring, `"nextPageHint":2`)
+			})
+		})
+	})
+
+	Convey("Given an item with unknown fields inside its nested objects", t, func() {
+		data := []byte(`{
+			"id": "1",
+			"type": "shape",
+			"data": {"content": "Start", "shape": "flow_chart_terminator"},
+			"style": {"fillColor": "#ffffff", "newThing": {"enabled": true}},
+			"position": {"x": 1, "y": 2, "origin": "center", "relativeTo": "canvas_center", "zIndex": 3},
+			"geometry": {"width": 10, "height": 20, "depth": 1},
+			"createdAt": "2023-01-01T00:00:00Z",
+			"createdBy": {"id": "2", "type": "user", "avatar": "https://example.com/a.png"},
+			"modifiedAt": "2023-01-02T00:00:00Z",
+			"modifiedBy": {"id": "2", "type": "user"},
+			"links": {"self": "https://api.miro.com/v2/boards/b/items/1", "board": "https://example.com"}
+		}`)
+
+		Convey("When it is decoded and marshalled again", func() {
+			var shape ShapeItem
+			So(json.Unmarshal(data, &shape), ShouldBeNil)
+			roundTrip, err := json.Marshal(shape)
+			So(err, ShouldBeNil)
+
+			Convey("Then the unknown fields of the nested objects are kept, by the objects or by their path", func() {
+				So(shape.Extra, ShouldResemble, Extra{
+					"style.newThing": json.RawMessage(`{"enabled": true}`),
+					"geometry.depth": json.RawMessage(`1`),
+				})
+				So(shape.Position.Extra, ShouldResemble, Extra{"zIndex": json.RawMessage(`3`)})
+				So(shape.CreatedBy.Extra, ShouldResemble, Extra{"avatar": json.RawMessage(`"https://example.com/a.png"`)})
+				So(shape.Links.Extra, ShouldResemble, Extra{"board": json.RawMessage(`"https://example.com"`)})
+				So(compareJSON(roundTrip, data), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a board member with unknown fields", t, func() {
+		data := []byte(`{"id": "3", "name": "Jane", "type": "board_member", "role": "editor", "links": {"self": "https://example.com"}, "invitedAt": "2023-01-01"}`)
+
+		Convey("When it is decoded and marshalled again", func() {
+			var member BoardMember
+			So(json.Unmarshal(data, &member), ShouldBeNil)
+			roundTrip, err := json.Marshal(member)
+			So(err, ShouldBeNil)
+
+			Convey("Then the fields of the embedded entity info are decoded & the unknown fields kept", func() {
+				So(member.ID, ShouldEqual, "3")
+				So(member.Name, ShouldEqual, "Jane")
+				So(member.Role, ShouldEqual, Role("editor"))
+				So(member.Extra, ShouldResemble, Extra{"invitedAt": json.RawMessage(`"2023-01-01"`)})
+				So(compareJSON(roundTrip, data), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a connector with unknown fields inside the objects of a nested array", t, func() {
+		data := []byte(`{
+			"id": "1",
+			"captions": [{"content": "yes", "position": "50%"}, {"content": "no", "newThing": [1, 2]}],
+			"style": {"strokeColor": "#000000", "newThing": true},
+			"createdAt": "2023-01-01T00:00:00Z",
+			"modifiedAt": "2023-01-02T00:00:00Z",
+			"startItem": {"id": "2"},
+			"endItem": {"id": "3"},
+			"type": "connector"
+		}`)
+
+		Convey("When it is decoded and marshalled again", func() {
+			var connector Connector
+			So(json.Unmarshal(data, &connector), ShouldBeNil)
+			roundTrip, err := json.Marshal(connector)
+			So(err, ShouldBeNil)
+
+			Convey("Then the unknown fields are kept by the index of their object", func() {
+				So(connector.Extra["captions.1.newThing"], ShouldResemble, json.RawMessage(`[1, 2]`))
+				So(connector.Extra["style.newThing"], ShouldResemble, json.RawMessage(`true`))
+				So(string(roundTrip), ShouldContainSubstring, `{"content":"no","position":"","textAlignVertical":"","newThing":[1,2]}`)
+				So(string(roundTrip), ShouldContainSubstring, `"newThing":true}`)
+			})
+		})
+	})
+
+}
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type FramesService struct {
 	client      *Client
 	apiVersion  string
@@ -10,6 +12,11 @@ type FramesService struct {
 // Create a frame on a board.
 // Required scope: boards:write | Rate limiting: Level 2
 func (f *FramesService) Create(boardID string, payload SetFrameItem) (*FrameItem, error) {
+	return f.CreateWithContext(f.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (f *FramesService) CreateWithContext(ctx context.Context, boardID string, payload SetFrameItem) (*FrameItem, error) {
 	response := &FrameItem{}
 
 	setPayloadDefaults(&payload)
@@ -17,7 +24,7 @@ func (f *FramesService) Create(boardID string, payload SetFrameItem) (*FrameItem
 	if url, err := constructURL(f.client.BaseURL, f.apiVersion, f.resource, boardID, f.subResource); err != nil {
 		return response, err
 	} else {
-		err = f.client.Post(f.client.ctx, url, payload, response)
+		err = f.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -25,12 +32,17 @@ func (f *FramesService) Create(boardID string, payload SetFrameItem) (*FrameItem
 // Get information for a specific frame on a board.
 // Required scope: boards:read | Rate limiting: Level 1
 func (f *FramesService) Get(boardID, itemID string) (*FrameItem, error) {
+	return f.GetWithContext(f.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (f *FramesService) GetWithContext(ctx context.Context, boardID, itemID string) (*FrameItem, error) {
 	response := &FrameItem{}
 
 	if url, err := constructURL(f.client.BaseURL, f.apiVersion, f.resource, boardID, f.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = f.client.Get(f.client.ctx, url, response)
+		err = f.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
@@ -47,25 +59,66 @@ func (f *FramesService) Get(boardID, itemID string) (*FrameItem, error) {
 // Required scope: boards:read | Rate limiting: Level 2
 // Search query params: ItemSearchParams{}
 func (f *FramesService) GetItems(boardID, frameID string, queryParams ...ItemSearchParams) (*ListItems, error) {
+	return f.GetItemsWithContext(f.client.ctx, boardID, frameID, queryParams...)
+}
+
+// GetItemsWithContext GetItems using the given context, which can be used to cancel the request or set a deadline.
+func (f *FramesService) GetItemsWithContext(ctx context.Context, boardID, frameID string, queryParams ...ItemSearchParams) (*ListItems, error) {
 	response := &ListItems{}
 
 	if url, err := constructURL(f.client.BaseURL, f.apiVersion, f.resource, boardID, "items"); err != nil {
 		return response, err
 	} else {
-		var searchParams []Parameter
+		var params ItemSearchParams
 		if len(queryParams) > 0 {
-			searchParams = parseQueryTags(queryParams[0])
+			params = queryParams[0]
+		}
+		params.ParentItemID = frameID
+
+		var searchParams []Parameter
+		if searchParams, err = encodeQueryTags(params); err != nil {
+			return response, err
 		}
-		searchParams = append(searchParams, Parameter{"parent_item_id": frameID})
-		err = f.client.Get(f.client.ctx, url, response, searchParams...)
+		err = f.client.Get(withRateLimitLevel(ctx, RateLimitLevel2), url, response, searchParams...)
 
 		return response, err
 	}
 }
 
+// IterateItems returns an iterator over all the items within a frame, fetching the pages of results as they are needed.
+// The Cursor of the search params is used as the starting point.
+// Required scope: boards:read | Rate limiting: Level 2 (per page)
+func (f *FramesService) IterateItems(boardID, frameID string, queryParams ...ItemSearchParams) *Iterator[Item] {
+	return f.IterateItemsWithContext(f.client.ctx, boardID, frameID, queryParams...)
+}
+
+// IterateItemsWithContext IterateItems using the given context, which can be used to cancel the fetching of pages or set
+// a deadline.
+func (f *FramesService) IterateItemsWithContext(ctx context.Context, boardID, frameID string, queryParams ...ItemSearchParams) *Iterator[Item] {
+	var params ItemSearchParams
+	if len(queryParams) > 0 {
+		params = queryParams[0]
+	}
+
+	iter := newIterator(ctx, func(ctx context.Context, cursor string) ([]Item, string, error) {
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+		response, err := f.GetItemsWithContext(ctx, boardID, frameID, params)
+		return response.Data, response.Cursor, err
+	})
+	iter.next = params.Cursor
+	return iter
+}
+
 // Update a frame on a board based on the data, style, or geometry properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 2
-func (f *FramesService) Update(boardID, itemID string, payload SetFrameItem) (*FrameItem, error) {
+func (f *FramesService) Update(boardID, itemID string, payload SetFrameItem, fields ...PatchField) (*FrameItem, error) {
+	return f.UpdateWithContext(f.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (f *FramesService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload SetFrameItem, fields ...PatchField) (*FrameItem, error) {
 	response := &FrameItem{}
 
 	setPayloadDefaults(&payload)
@@ -73,7 +126,7 @@ func (f *FramesService) Update(boardID, itemID string, payload SetFrameItem) (*F
 	if url, err := constructURL(f.client.BaseURL, f.apiVersion, f.resource, boardID, f.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = f.client.Patch(f.client.ctx, url, payload, response)
+		err = f.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -81,10 +134,15 @@ func (f *FramesService) Update(boardID, itemID string, payload SetFrameItem) (*F
 // Delete the specified frame from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (f *FramesService) Delete(boardID, itemID string) error {
+	return f.DeleteWithContext(f.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (f *FramesService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(f.client.BaseURL, f.apiVersion, f.resource, boardID, f.subResource, itemID); err != nil {
 		return err
 	} else {
-		return f.client.Delete(f.client.ctx, url)
+		return f.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
 
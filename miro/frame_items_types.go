@@ -46,4 +46,15 @@ type FrameItem struct {
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
 	Parent     *Parent         `json:"parent,omitempty"`
+	Extra      Extra           `json:"-"`
+}
+
+func (f *FrameItem) UnmarshalJSON(data []byte) error {
+	type frameItem FrameItem
+	return unmarshalWithExtra(data, (*frameItem)(f), &f.Extra)
+}
+
+func (f FrameItem) MarshalJSON() ([]byte, error) {
+	type frameItem FrameItem
+	return marshalWithExtra(frameItem(f), f.Extra)
 }
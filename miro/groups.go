@@ -0,0 +1,197 @@
+package miro
+
+import "context"
+
+type GroupsService struct {
+	client      *Client
+	apiVersion  string
+	resource    string
+	subResource string
+}
+
+// Create a group of items on a board. The group is created with the items that are specified in the request body.
+// Required scope: boards:write | Rate limiting: Level 2
+func (g *GroupsService) Create(boardID string, payload GroupSet) (*Group, error) {
+	return g.CreateWithContext(g.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (g *GroupsService) CreateWithContext(ctx context.Context, boardID string, payload GroupSet) (*Group, error) {
+	response := &Group{}
+
+	if url, err := constructURL(g.client.BaseURL, g.apiVersion, g.resource, boardID, g.subResource); err != nil {
+		return response, err
+	} else {
+		err = g.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
+		return response, err
+	}
+}
+
+// Get a group, with the IDs of the items in it.
+// Required scope: boards:read | Rate limiting: Level 2
+func (g *GroupsService) Get(boardID, groupID string) (*Group, error) {
+	return g.GetWithContext(g.client.ctx, boardID, groupID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (g *GroupsService) GetWithContext(ctx context.Context, boardID, groupID string) (*Group, error) {
+	response := &Group{}
+
+	if url, err := constructURL(g.client.BaseURL, g.apiVersion, g.resource, boardID, g.subResource, groupID); err != nil {
+		return response, err
+	} else {
+		err = g.client.Get(withRateLimitLevel(ctx, RateLimitLevel2), url, response)
+		return response, err
+	}
+}
+
+// GetAll groups on a board. This method returns results using a cursor-based approach, see ItemsService.GetAll.
+// Required scope: boards:read | Rate limiting: Level 2
+// Search query params: GroupSearchParams{}
+func (g *GroupsService) GetAll(boardID string, queryParams ...GroupSearchParams) (*ListGroups, error) {
+	return g.GetAllWithContext(g.client.ctx, boardID, queryParams...)
+}
+
+// GetAllWithContext GetAll using the given context, which can be used to cancel the request or set a deadline.
+func (g *GroupsService) GetAllWithContext(ctx context.Context, boardID string, queryParams ...GroupSearchParams) (*ListGroups, error) {
+	response := &ListGroups{}
+
+	if url, err := constructURL(g.client.BaseURL, g.apiVersion, g.resource, boardID, g.subResource); err != nil {
+		return response, err
+	} else {
+		var searchParams []Parameter
+		if len(queryParams) > 0 {
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
+		}
+		err = g.client.Get(withRateLimitLevel(ctx, RateLimitLevel2), url, response, searchParams...)
+
+		return response, err
+	}
+}
+
+// Iterate returns an iterator over all the groups on a board, fetching the pages of results as they are needed. The
+// Cursor of the search params is used as the starting point.
+// Required scope: boards:read | Rate limiting: Level 2 (per page)
+func (g *GroupsService) Iterate(boardID string, queryParams ...GroupSearchParams) *Iterator[Group] {
+	return g.IterateWithContext(g.client.ctx, boardID, queryParams...)
+}
+
+// IterateWithContext Iterate using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (g *GroupsService) IterateWithContext(ctx context.Context, boardID string, queryParams ...GroupSearchParams) *Iterator[Group] {
+	var params GroupSearchParams
+	if len(queryParams) > 0 {
+		params = queryParams[0]
+	}
+
+	iter := newIterator(ctx, func(ctx context.Context, cursor string) ([]Group, string, error) {
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+		response, err := g.GetAllWithContext(ctx, boardID, params)
+		return response.Data, response.Cursor, err
+	})
+	iter.next = params.Cursor
+	return iter
+}
+
+// GetItems retrieves the items in a group. The items can be decoded into their types with ListItems.Decode.
+// Required scope: boards:read | Rate limiting: Level 2
+// Search query params: GroupSearchParams{}
+func (g *GroupsService) GetItems(boardID, groupID string, queryParams ...GroupSearchParams) (*ListItems, error) {
+	return g.GetItemsWithContext(g.client.ctx, boardID, groupID, queryParams...)
+}
+
+// GetItemsWithContext GetItems using the given context, which can be used to cancel the request or set a deadline.
+func (g *GroupsService) GetItemsWithContext(ctx context.Context, boardID, groupID string, queryParams ...GroupSearchParams) (*ListItems, error) {
+	response := &ListItems{}
+
+	if url, err := constructURL(g.client.BaseURL, g.apiVersion, g.resource, boardID, g.subResource, "items"); err != nil {
+		return response, err
+	} else {
+		var searchParams []Parameter
+		if len(queryParams) > 0 {
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
+		}
+		searchParams = append(searchParams, Parameter{"group_item_id": groupID})
+
+		err = g.client.Get(withRateLimitLevel(ctx, RateLimitLevel2), url, response, searchParams...)
+		return response, err
+	}
+}
+
+// IterateItems returns an iterator over all the items in a group, fetching the pages of results as they are needed.
+// Required scope: boards:read | Rate limiting: Level 2 (per page)
+func (g *GroupsService) IterateItems(boardID, groupID string, queryParams ...GroupSearchParams) *Iterator[Item] {
+	return g.IterateItemsWithContext(g.client.ctx, boardID, groupID, queryParams...)
+}
+
+// IterateItemsWithContext IterateItems using the given context, which can be used to cancel the fetching of pages or set
+// a deadline.
+func (g *GroupsService) IterateItemsWithContext(ctx context.Context, boardID, groupID string, queryParams ...GroupSearchParams) *Iterator[Item] {
+	var params GroupSearchParams
+	if len(queryParams) > 0 {
+		params = queryParams[0]
+	}
+
+	iter := newIterator(ctx, func(ctx context.Context, cursor string) ([]Item, string, error) {
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+		response, err := g.GetItemsWithContext(ctx, boardID, groupID, params)
+		return response.Data, response.Cursor, err
+	})
+	iter.next = params.Cursor
+	return iter
+}
+
+// Update a group with the items in the request body, replacing the items that were in it.
+// Required scope: boards:write | Rate limiting: Level 2
+func (g *GroupsService) Update(boardID, groupID string, payload GroupSet) (*Group, error) {
+	return g.UpdateWithContext(g.client.ctx, boardID, groupID, payload)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (g *GroupsService) UpdateWithContext(ctx context.Context, boardID, groupID string, payload GroupSet) (*Group, error) {
+	response := &Group{}
+
+	if url, err := constructURL(g.client.BaseURL, g.apiVersion, g.resource, boardID, g.subResource, groupID); err != nil {
+		return response, err
+	} else {
+		err = g.client.Put(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
+		return response, err
+	}
+}
+
+// Ungroup the items in a group. The items are kept on the board.
+// Required scope: boards:write | Rate limiting: Level 3
+func (g *GroupsService) Ungroup(boardID, groupID string) error {
+	return g.UngroupWithContext(g.client.ctx, boardID, groupID)
+}
+
+// UngroupWithContext Ungroup using the given context, which can be used to cancel the request or set a deadline.
+func (g *GroupsService) UngroupWithContext(ctx context.Context, boardID, groupID string) error {
+	if url, err := constructURL(g.client.BaseURL, g.apiVersion, g.resource, boardID, g.subResource, groupID); err != nil {
+		return err
+	} else {
+		return g.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url, Parameter{"delete_items": "false"})
+	}
+}
+
+// Delete a group along with the items in it.
+// Required scope: boards:write | Rate limiting: Level 3
+func (g *GroupsService) Delete(boardID, groupID string) error {
+	return g.DeleteWithContext(g.client.ctx, boardID, groupID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (g *GroupsService) DeleteWithContext(ctx context.Context, boardID, groupID string) error {
+	if url, err := constructURL(g.client.BaseURL, g.apiVersion, g.resource, boardID, g.subResource, groupID); err != nil {
+		return err
+	} else {
+		return g.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url, Parameter{"delete_items": "true"})
+	}
+}
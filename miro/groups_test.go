@@ -0,0 +1,238 @@
+package miro
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const testGroupID = "3458764517517819000"
+
+func TestCreateGroup(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "groups")
+	defer closeAPIServer()
+
+	expectedResults := &Group{}
+	responseData := constructResponseAndResults("groups_get.json", expectedResults)
+
+	Convey("Given a board ID and the IDs of the items to group", t, func() {
+		Convey("When the Create method is called", func() {
+			var receivedRequest *http.Request
+			var body GroupSet
+			mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&body)
+				w.WriteHeader(http.StatusCreated)
+				w.Write(responseData)
+				receivedRequest = r
+			})
+
+			items := []string{"3458764517517818867", "3458764517517818868", "3458764517517818869"}
+			results, err := client.Groups.Create(testBoardID, GroupSet{Data: GroupDataSet{Items: items}})
+
+			Convey("Then the group is created", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+				So(results.Data.Items, ShouldResemble, items)
+
+				Convey("And the request contains the expected headers and parameters", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodPost)
+					So(receivedRequest.Header.Get("Authorization"), ShouldEqual, fmt.Sprintf("Bearer %s", testToken))
+					So(receivedRequest.URL.Path, ShouldEqual, testResourcePath)
+					So(body.Data.Items, ShouldResemble, items)
+				})
+			})
+		})
+	})
+}
+
+func TestGetGroup(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "groups")
+	defer closeAPIServer()
+
+	expectedResults := &Group{}
+	responseData := constructResponseAndResults("groups_get.json", expectedResults)
+
+	Convey("Given a board ID and a group ID", t, func() {
+		Convey("When the Get method is called", func() {
+			var receivedRequest *http.Request
+			mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, testGroupID), func(w http.ResponseWriter, r *http.Request) {
+				w.Write(responseData)
+				receivedRequest = r
+			})
+
+			results, err := client.Groups.Get(testBoardID, testGroupID)
+
+			Convey("Then the group is returned", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+
+				Convey("And the request contains the expected headers and parameters", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodGet)
+					So(receivedRequest.URL.Path, ShouldEqual, fmt.Sprintf("%s/%s", testResourcePath, testGroupID))
+				})
+			})
+		})
+	})
+}
+
+func TestGetAllGroups(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "groups")
+	defer closeAPIServer()
+
+	expectedResults := &ListGroups{}
+	responseData := constructResponseAndResults("groups_get_all.json", expectedResults)
+
+	var receivedRequest *http.Request
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(responseData)
+		receivedRequest = r
+	})
+
+	Convey("Given a board ID and GroupSearchParams", t, func() {
+		Convey("When the GetAll method is called", func() {
+			results, err := client.Groups.GetAll(testBoardID, GroupSearchParams{Limit: "10"})
+
+			Convey("Then a list of groups is returned", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+
+				Convey("And the request contains the expected headers and parameters", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodGet)
+					So(receivedRequest.URL.Query().Get("limit"), ShouldEqual, "10")
+					So(receivedRequest.URL.Path, ShouldEqual, testResourcePath)
+				})
+			})
+		})
+
+		Convey("When the groups are iterated over", func() {
+			groups, err := client.Groups.Iterate(testBoardID).All()
+
+			Convey("Then every group is returned", func() {
+				So(err, ShouldBeNil)
+				So(groups, ShouldResemble, expectedResults.Data)
+			})
+		})
+	})
+}
+
+func TestGetGroupItems(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "groups")
+	defer closeAPIServer()
+
+	expectedResults := &ListItems{}
+	responseData := constructResponseAndResults("groups_get_items.json", expectedResults)
+
+	var receivedRequest *http.Request
+	mux.HandleFunc(testResourcePath+"/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(responseData)
+		receivedRequest = r
+	})
+
+	Convey("Given a board ID and a group ID", t, func() {
+		Convey("When the GetItems method is called", func() {
+			results, err := client.Groups.GetItems(testBoardID, testGroupID)
+
+			Convey("Then the items in the group are returned", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+
+				Convey("And they can be decoded into their types", func() {
+					typed, err := results.Decode()
+					So(err, ShouldBeNil)
+					So(typed, ShouldHaveLength, 2)
+					So(typed[0].(*ShapeItem).Data.Shape, ShouldEqual, ShapeRectangle)
+					So(typed[1].(*TextItem).Data.Content, ShouldEqual, "<p>Caption</p>")
+				})
+
+				Convey("And the request contains the expected headers and parameters", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodGet)
+					So(receivedRequest.URL.Query().Get("group_item_id"), ShouldEqual, testGroupID)
+					So(receivedRequest.URL.Path, ShouldEqual, testResourcePath+"/items")
+				})
+			})
+		})
+
+		Convey("When the items in the group are iterated over", func() {
+			items, err := client.Groups.IterateItems(testBoardID, testGroupID).All()
+
+			Convey("Then every item is returned", func() {
+				So(err, ShouldBeNil)
+				So(items, ShouldResemble, expectedResults.Data)
+			})
+		})
+	})
+}
+
+func TestUpdateGroup(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "groups")
+	defer closeAPIServer()
+
+	expectedResults := &Group{}
+	responseData := constructResponseAndResults("groups_get.json", expectedResults)
+
+	Convey("Given a board ID, a group ID and the items of the group", t, func() {
+		Convey("When the Update method is called", func() {
+			var receivedRequest *http.Request
+			var body string
+			mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, testGroupID), func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				body = string(data)
+				w.Write(responseData)
+				receivedRequest = r
+			})
+
+			results, err := client.Groups.Update(testBoardID, testGroupID, GroupSet{Data: GroupDataSet{Items: []string{"1", "2"}}})
+
+			Convey("Then the group is updated", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+
+				Convey("And the items of the group are replaced", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodPut)
+					So(body, ShouldEqual, `{"data":{"items":["1","2"]}}`)
+				})
+			})
+		})
+	})
+}
+
+func TestUngroupAndDeleteGroup(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "groups")
+	defer closeAPIServer()
+
+	var receivedRequest *http.Request
+	mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, testGroupID), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		receivedRequest = r
+	})
+
+	Convey("Given a board ID and a group ID", t, func() {
+		Convey("When the Ungroup method is called", func() {
+			err := client.Groups.Ungroup(testBoardID, testGroupID)
+
+			Convey("Then the group is removed, keeping its items", func() {
+				So(err, ShouldBeNil)
+				So(receivedRequest.Method, ShouldEqual, http.MethodDelete)
+				So(receivedRequest.URL.Query().Get("delete_items"), ShouldEqual, "false")
+			})
+		})
+
+		Convey("When the Delete method is called", func() {
+			err := client.Groups.Delete(testBoardID, testGroupID)
+
+			Convey("Then the group is removed along with its items", func() {
+				So(err, ShouldBeNil)
+				So(receivedRequest.Method, ShouldEqual, http.MethodDelete)
+				So(receivedRequest.URL.Query().Get("delete_items"), ShouldEqual, "true")
+			})
+		})
+	})
+}
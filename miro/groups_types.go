@@ -0,0 +1,79 @@
+package miro
+
+type GroupData struct {
+	// Items The IDs of the items in the group.
+	Items []string `json:"items"`
+	Extra Extra    `json:"-"`
+}
+
+func (g *GroupData) UnmarshalJSON(data []byte) error {
+	type groupData GroupData
+	return unmarshalWithExtra(data, (*groupData)(g), &g.Extra)
+}
+
+func (g GroupData) MarshalJSON() ([]byte, error) {
+	type groupData GroupData
+	return marshalWithExtra(groupData(g), g.Extra)
+}
+
+type Group struct {
+	// ID Unique identifier (ID) of the group.
+	ID string `json:"id"`
+	// Type Type of the object, group.
+	Type  string    `json:"type"`
+	Data  GroupData `json:"data"`
+	Links Links     `json:"links"`
+	Extra Extra     `json:"-"`
+}
+
+func (g *Group) UnmarshalJSON(data []byte) error {
+	type group Group
+	return unmarshalWithExtra(data, (*group)(g), &g.Extra)
+}
+
+func (g Group) MarshalJSON() ([]byte, error) {
+	type group Group
+	return marshalWithExtra(group(g), g.Extra)
+}
+
+type GroupSet struct {
+	// Data The items to group. (required)
+	Data GroupDataSet `json:"data"`
+}
+
+type GroupDataSet struct {
+	// Items The IDs of the items to group. A group must contain at least 2 items, and an item can only be in one group.
+	Items []string `json:"items"`
+}
+
+type ListGroups struct {
+	Data   []Group         `json:"data"`
+	Total  int             `json:"total"`
+	Size   int             `json:"size"`
+	Cursor string          `json:"cursor,omitempty"`
+	Limit  int             `json:"limit"`
+	Links  PaginationLinks `json:"links"`
+	Type   string          `json:"type"`
+	Extra  Extra           `json:"-"`
+}
+
+func (l *ListGroups) UnmarshalJSON(data []byte) error {
+	type listGroups ListGroups
+	return unmarshalWithExtra(data, (*listGroups)(l), &l.Extra)
+}
+
+func (l ListGroups) MarshalJSON() ([]byte, error) {
+	type listGroups ListGroups
+	return marshalWithExtra(listGroups(l), l.Extra)
+}
+
+type GroupSearchParams struct {
+	// Limit The maximum number of results to return per call. If the number of groups in the response is greater than
+	// the limit specified, the response returns the cursor parameter with a value.
+	// Default: 10. Minimum 10. Maximum 50.
+	Limit string `query:"limit,omitempty"`
+	// Cursor A cursor-paginated method returns a portion of the total set of results based on the limit specified and a
+	// cursor that points to the next portion of the results. To retrieve the next portion of the collection, set the
+	// cursor parameter equal to the cursor value you received in the response of the previous request.
+	Cursor string `query:"cursor,omitempty"`
+}
@@ -1,8 +1,7 @@
 package miro
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"io"
 	"os"
 	"path"
@@ -18,12 +17,17 @@ type ImagesService struct {
 // Create an image item on a board by specifying the URL where the image is hosted.
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *ImagesService) Create(boardID string, payload ImageItemSet) (*ImageItem, error) {
+	return c.CreateWithContext(c.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) CreateWithContext(ctx context.Context, boardID string, payload ImageItemSet) (*ImageItem, error) {
 	response := &ImageItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
 	} else {
-		err = c.client.Post(c.client.ctx, url, payload, response)
+		err = c.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -32,35 +36,38 @@ func (c *ImagesService) Create(boardID string, payload ImageItemSet) (*ImageItem
 // The maximum file size supported is 28.6 MB.
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *ImagesService) Upload(boardID, filePath string, payload UploadFileItem) (*ImageItem, error) {
-	response := &ImageItem{}
+	return c.UploadWithContext(c.client.ctx, boardID, filePath, payload)
+}
 
+// UploadWithContext Upload using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) UploadWithContext(ctx context.Context, boardID, filePath string, payload UploadFileItem) (*ImageItem, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return response, err
+		return &ImageItem{}, err
 	}
-	fileName := path.Base(file.Name())
+	defer file.Close()
 
-	buf := &bytes.Buffer{}
-	if err := json.NewEncoder(buf).Encode(payload); err != nil {
-		return nil, err
-	}
+	return c.UploadFromReaderWithContext(ctx, boardID, path.Base(file.Name()), file, payload)
+}
 
-	multiParts := make(MultiParts)
-	multiParts["resource"] = MultiPart{
-		Reader:      io.Reader(file),
-		FileName:    fileName,
-		ContentType: "application/octet-stream",
-	}
-	multiParts["data"] = MultiPart{
-		Reader:      buf,
-		FileName:    fileName,
-		ContentType: "application/json",
-	}
+// UploadFromReader upload a image item, streaming the file from the reader rather than holding it in memory. The
+// content type of the file is detected from its contents. Use WithUploadProgress to report the progress of the upload.
+// The maximum file size supported is 28.6 MB.
+// Required scope: boards:write | Rate limiting: Level 2
+func (c *ImagesService) UploadFromReader(boardID, fileName string, reader io.Reader, payload UploadFileItem) (*ImageItem, error) {
+	return c.UploadFromReaderWithContext(c.client.ctx, boardID, fileName, reader, payload)
+}
+
+// UploadFromReaderWithContext UploadFromReader using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) UploadFromReaderWithContext(ctx context.Context, boardID, fileName string, reader io.Reader, payload UploadFileItem) (*ImageItem, error) {
+	response := &ImageItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource); err != nil {
 		return response, err
+	} else if multiParts, err := c.client.newUploadParts(ctx, fileName, reader, payload); err != nil {
+		return response, err
 	} else {
-		err = c.client.PostMultipart(c.client.ctx, url, multiParts, response)
+		err = c.client.PostMultipart(withRateLimitLevel(ctx, RateLimitLevel2), url, multiParts, response)
 		return response, err
 	}
 }
@@ -68,25 +75,35 @@ func (c *ImagesService) Upload(boardID, filePath string, payload UploadFileItem)
 // Get information for a specific image item on a board.
 // Required scope: boards:read | Rate limiting: Level 1
 func (c *ImagesService) Get(boardID, itemID string) (*ImageItem, error) {
+	return c.GetWithContext(c.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) GetWithContext(ctx context.Context, boardID, itemID string) (*ImageItem, error) {
 	response := &ImageItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Get(c.client.ctx, url, response)
+		err = c.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update an image item on a board.
 // Required scope: boards:write | Rate limiting: Level 2
-func (c *ImagesService) Update(boardID, itemID string, payload ImageItemSet) (*ImageItem, error) {
+func (c *ImagesService) Update(boardID, itemID string, payload ImageItemSet, fields ...PatchField) (*ImageItem, error) {
+	return c.UpdateWithContext(c.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload ImageItemSet, fields ...PatchField) (*ImageItem, error) {
 	response := &ImageItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = c.client.Patch(c.client.ctx, url, payload, response)
+		err = c.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -94,35 +111,37 @@ func (c *ImagesService) Update(boardID, itemID string, payload ImageItemSet) (*I
 // UpdateFromFile update image item using a file from a device.
 // Required scope: boards:write | Rate limiting: Level 2
 func (c *ImagesService) UpdateFromFile(boardID, itemID, filePath string, payload UploadFileItem) (*ImageItem, error) {
-	response := &ImageItem{}
+	return c.UpdateFromFileWithContext(c.client.ctx, boardID, itemID, filePath, payload)
+}
 
+// UpdateFromFileWithContext UpdateFromFile using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) UpdateFromFileWithContext(ctx context.Context, boardID, itemID, filePath string, payload UploadFileItem) (*ImageItem, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return response, err
+		return &ImageItem{}, err
 	}
-	fileName := path.Base(file.Name())
+	defer file.Close()
 
-	buf := &bytes.Buffer{}
-	if err := json.NewEncoder(buf).Encode(payload); err != nil {
-		return nil, err
-	}
+	return c.UpdateFromReaderWithContext(ctx, boardID, itemID, path.Base(file.Name()), file, payload)
+}
 
-	multiParts := make(MultiParts)
-	multiParts["resource"] = MultiPart{
-		Reader:      io.Reader(file),
-		FileName:    fileName,
-		ContentType: "application/octet-stream",
-	}
-	multiParts["data"] = MultiPart{
-		Reader:      buf,
-		FileName:    fileName,
-		ContentType: "application/json",
-	}
+// UpdateFromReader update a image item, streaming the file from the reader rather than holding it in memory. The
+// content type of the file is detected from its contents. Use WithUploadProgress to report the progress of the upload.
+// Required scope: boards:write | Rate limiting: Level 2
+func (c *ImagesService) UpdateFromReader(boardID, itemID, fileName string, reader io.Reader, payload UploadFileItem) (*ImageItem, error) {
+	return c.UpdateFromReaderWithContext(c.client.ctx, boardID, itemID, fileName, reader, payload)
+}
+
+// UpdateFromReaderWithContext UpdateFromReader using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) UpdateFromReaderWithContext(ctx context.Context, boardID, itemID, fileName string, reader io.Reader, payload UploadFileItem) (*ImageItem, error) {
+	response := &ImageItem{}
 
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return response, err
+	} else if multiParts, err := c.client.newUploadParts(ctx, fileName, reader, payload); err != nil {
+		return response, err
 	} else {
-		err = c.client.PatchMultipart(c.client.ctx, url, multiParts, response)
+		err = c.client.PatchMultipart(withRateLimitLevel(ctx, RateLimitLevel2), url, multiParts, response)
 		return response, err
 	}
 }
@@ -130,9 +149,14 @@ func (c *ImagesService) UpdateFromFile(boardID, itemID, filePath string, payload
 // Delete an image item from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (c *ImagesService) Delete(boardID, itemID string) error {
+	return c.DeleteWithContext(c.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (c *ImagesService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(c.client.BaseURL, c.apiVersion, c.resource, boardID, c.subResource, itemID); err != nil {
 		return err
 	} else {
-		return c.client.Delete(c.client.ctx, url)
+		return c.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
@@ -1,9 +1,13 @@
 package miro
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"io"
 	"net/http"
 	"testing"
 )
@@ -234,3 +238,100 @@ func TestDeleteImageItem(t *testing.T) {
 		})
 	})
 }
+
+func TestUploadImageItemWithCancelledContext(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "images")
+	defer closeAPIServer()
+
+	requests := 0
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	Convey("Given a board ID, a file & a cancelled context", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Convey("When the UploadWithContext method is called", func() {
+			_, err := client.Images.UploadWithContext(ctx, testBoardID, "./test_data/image_item_get.json", UploadFileItem{Title: "A test upload"})
+
+			Convey("Then the upload is aborted and the context error is returned", func() {
+				So(errors.Is(err, context.Canceled), ShouldBeTrue)
+				So(requests, ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestUploadImageItemFromReader(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "images")
+	defer closeAPIServer()
+
+	pngHeader := []byte("\x89PNG\r\n\x1a\n")
+	fileContents := append(pngHeader, bytes.Repeat([]byte("gopher"), 1000)...)
+
+	var receivedContentType string
+	var receivedFile []byte
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseMultipartForm(32 << 20)
+		file, header, _ := r.FormFile("resource")
+		defer file.Close()
+		receivedContentType = header.Header.Get("Content-Type")
+		receivedFile, _ = io.ReadAll(file)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ImageItem{ID: testItemID})
+	})
+
+	Convey("Given a board ID and a reader", t, func() {
+		Convey("When the UploadFromReaderWithContext method is called with a progress function", func() {
+			var progress, totals []int64
+			ctx := WithUploadProgress(context.Background(), func(sent, total int64) {
+				progress = append(progress, sent)
+				totals = append(totals, total)
+			})
+
+			// wrap the reader so that it can't be seeked
+			reader := io.MultiReader(bytes.NewReader(fileContents))
+			results, err := client.Images.UploadFromReaderWithContext(ctx, testBoardID, "gopher.png", reader, UploadFileItem{Title: "A test upload"})
+
+			Convey("Then the file is streamed with its detected content type", func() {
+				So(err, ShouldBeNil)
+				So(results.ID, ShouldEqual, testItemID)
+				So(receivedContentType, ShouldEqual, "image/png")
+				So(receivedFile, ShouldResemble, fileContents)
+
+				Convey("And the progress of the upload is reported", func() {
+					So(len(progress), ShouldBeGreaterThan, 0)
+					So(progress[len(progress)-1], ShouldEqual, len(fileContents))
+					So(totals[len(totals)-1], ShouldEqual, -1)
+				})
+			})
+		})
+
+		Convey("When the UploadFromReader method is called without a board ID", func() {
+			reader := bytes.NewReader(fileContents)
+			_, err := client.Images.UploadFromReader("", "gopher.png", reader, UploadFileItem{Title: "A test upload"})
+
+			Convey("Then nothing is read from the reader", func() {
+				So(err, ShouldNotBeNil)
+				So(reader.Len(), ShouldEqual, len(fileContents))
+			})
+		})
+
+		Convey("When the UploadFromReader method is called with an invalid payload by a client that validates payloads", func() {
+			WithValidation()(client)
+			defer func() { client.validate = false }()
+			reader := bytes.NewReader(fileContents)
+			_, err := client.Images.UploadFromReader(testBoardID, "gopher.png", reader, UploadFileItem{Geometry: GeometrySet{Width: -1}})
+
+			Convey("Then nothing is read from the reader", func() {
+				var validationErr *ValidationError
+				So(errors.As(err, &validationErr), ShouldBeTrue)
+				So(err.Error(), ShouldContainSubstring, "geometry.width")
+				So(reader.Len(), ShouldEqual, len(fileContents))
+			})
+		})
+	})
+}
@@ -7,6 +7,17 @@ type ImageItemData struct {
 	ImageURL string `json:"imageUrl,omitempty"`
 	// Title A short text header to identify the image.
 	Title string `json:"title,omitempty"`
+	Extra Extra  `json:"-"`
+}
+
+func (i *ImageItemData) UnmarshalJSON(data []byte) error {
+	type imageItemData ImageItemData
+	return unmarshalWithExtra(data, (*imageItemData)(i), &i.Extra)
+}
+
+func (i ImageItemData) MarshalJSON() ([]byte, error) {
+	type imageItemData ImageItemData
+	return marshalWithExtra(imageItemData(i), i.Extra)
 }
 
 type ImageItem struct {
@@ -21,6 +32,17 @@ type ImageItem struct {
 	Parent     *Parent         `json:"parent,omitempty"`
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
+	Extra      Extra           `json:"-"`
+}
+
+func (i *ImageItem) UnmarshalJSON(data []byte) error {
+	type imageItem ImageItem
+	return unmarshalWithExtra(data, (*imageItem)(i), &i.Extra)
+}
+
+func (i ImageItem) MarshalJSON() ([]byte, error) {
+	type imageItem ImageItem
+	return marshalWithExtra(imageItem(i), i.Extra)
 }
 
 type ImageItemSet struct {
@@ -0,0 +1,104 @@
+package miro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// maxBulkItems the maximum number of items the MIRO API creates in a single bulk request
+const maxBulkItems = 20
+
+// BulkItem an item to create with ItemsService.CreateBulk, see NewBulkItem
+type BulkItem struct {
+	Type    ItemType
+	payload interface{}
+	fields  []PatchField
+}
+
+// NewBulkItem an item of the given type to create with ItemsService.CreateBulk, from the payload that would be used to
+// create it on its own, e.g.
+//
+//	miro.NewBulkItem(miro.ItemTypeStickyNote, miro.StickyNoteSet{Data: miro.StickyNoteData{Content: "Idea"}})
+//
+// As with an Update payload, only the fields that are set are sent, along with the fields included, see PatchField.
+func NewBulkItem(itemType ItemType, payload interface{}, fields ...PatchField) BulkItem {
+	return BulkItem{Type: itemType, payload: payload, fields: fields}
+}
+
+func (b BulkItem) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(newPatch(b.payload, b.fields))
+	if err != nil {
+		return nil, err
+	}
+
+	var item map[string]json.RawMessage
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+	if item["type"], err = json.Marshal(b.Type); err != nil {
+		return nil, err
+	}
+	return json.Marshal(item)
+}
+
+// Validate validates the payload of the item, see the Validate methods of the payloads
+func (b BulkItem) Validate() error {
+	return validatePayload(b.payload, "")
+}
+
+// validateBulkItems validate the payload of every item against the constraints of the API version, with the field
+// errors addressed by the index of the item, e.g. [3].style.fillColor
+func validateBulkItems(items []BulkItem, version APIVersion) error {
+	v := &validator{}
+	for i, item := range items {
+		err := validatePayload(item.payload, version)
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			for _, fieldErr := range validationErr.Errors {
+				v.addf(fmt.Sprintf("[%d].%s", i, fieldErr.Field), "%s", fieldErr.Msg)
+			}
+		} else if err != nil {
+			v.addf(fmt.Sprintf("[%d]", i), "%s", err)
+		}
+	}
+	return v.result("BulkItem")
+}
+
+// BulkCreateOptions options of ItemsService.CreateBulk
+type BulkCreateOptions struct {
+	// ChunkSize the number of items created by each request. Default & maximum: 20
+	ChunkSize int
+	// CleanUp delete the items created by the previous chunks when a chunk fails, so that either all the items are
+	// created or none are
+	CleanUp bool
+}
+
+// BulkCreateError the error returned by ItemsService.CreateBulk when a chunk of items can't be created. Each chunk is
+// created (or not) as a whole by the MIRO API, so the items before Start have been created, unless they were cleaned up.
+type BulkCreateError struct {
+	// Chunk the index of the chunk that failed, starting from 0
+	Chunk int
+	// Start & End the indexes of the first and last (exclusive) items of the chunk
+	Start, End int
+	// Created the items created by the previous chunks, in the order of the input, nil if they were cleaned up. If the
+	// chunk failed because fewer items were created than were sent, the items of the chunk that were created are
+	// included too
+	Created []Item
+	// CleanUpErr the first error deleting the items created by the previous chunks, those that couldn't be deleted are
+	// kept in Created
+	CleanUpErr error
+	Err        error
+}
+
+func (e *BulkCreateError) Error() string {
+	msg := fmt.Sprintf("creating items %d to %d (chunk %d): %v", e.Start, e.End-1, e.Chunk, e.Err)
+	if e.CleanUpErr != nil {
+		msg += fmt.Sprintf(", cleaning up: %v", e.CleanUpErr)
+	}
+	return msg
+}
+
+func (e *BulkCreateError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,183 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBulkItem(t *testing.T) {
+	Convey("Given an item to create in bulk", t, func() {
+		item := NewBulkItem(ItemTypeStickyNote, StickyNoteSet{Data: StickyNoteData{Content: "Idea"}}, IncludeField("position.x"))
+
+		Convey("When it is marshalled", func() {
+			data, err := json.Marshal(item)
+
+			Convey("Then its type is sent along with the fields that are set or included", func() {
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, `{"data":{"content":"Idea"},"position":{"x":0},"type":"sticky_note"}`)
+			})
+		})
+	})
+}
+
+func TestCreateBulk(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+
+	var chunks [][]map[string]interface{}
+	var deleted []string
+	failChunk, shortChunk := -1, -1
+	var cancel context.CancelFunc
+	mux.HandleFunc(testResourcePath+"/bulk", func(w http.ResponseWriter, r *http.Request) {
+		var chunk []map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&chunk)
+		if len(chunks) == failChunk {
+			if cancel != nil {
+				cancel()
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status": 400, "code": "invalidParameters", "message": "Invalid parameters"}`))
+			return
+		}
+		chunks = append(chunks, chunk)
+
+		response := ListItems{Type: "bulk-list"}
+		if len(chunks)-1 == shortChunk {
+			chunk = chunk[1:]
+		}
+		for _, item := range chunk {
+			content := item["data"].(map[string]interface{})["content"].(string)
+			response.Data = append(response.Data, Item{ID: "id-" + content, Type: item["type"].(string)})
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(response)
+	})
+	mux.HandleFunc(testResourcePath+"/", func(w http.ResponseWriter, r *http.Request) {
+		deleted = append(deleted, strings.TrimPrefix(r.URL.Path, testResourcePath+"/"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	Convey("Given 45 items of different types", t, func() {
+		chunks, deleted, failChunk, shortChunk, cancel = nil, nil, -1, -1, nil
+		var items []BulkItem
+		for i := 0; i < 45; i++ {
+			if i%2 == 0 {
+				items = append(items, NewBulkItem(ItemTypeStickyNote, StickyNoteSet{Data: StickyNoteData{Content: strconv.Itoa(i)}}))
+			} else {
+				items = append(items, NewBulkItem(ItemTypeText, TextItemSet{Data: TextItemData{Content: strconv.Itoa(i)}}))
+			}
+		}
+
+		Convey("When they are created in bulk", func() {
+			created, err := client.Items.CreateBulk(testBoardID, items)
+
+			Convey("Then they are sent in chunks of at most 20", func() {
+				So(err, ShouldBeNil)
+				So(chunks, ShouldHaveLength, 3)
+				So(chunks[0], ShouldHaveLength, 20)
+				So(chunks[2], ShouldHaveLength, 5)
+				So(chunks[0][1]["type"], ShouldEqual, "text")
+
+				Convey("And the items created are returned in the order of the input", func() {
+					So(created, ShouldHaveLength, 45)
+					for i, item := range created {
+						So(item.ID, ShouldEqual, "id-"+strconv.Itoa(i))
+					}
+				})
+			})
+		})
+
+		Convey("When a chunk fails", func() {
+			failChunk = 1
+			created, err := client.Items.CreateBulk(testBoardID, items, BulkCreateOptions{ChunkSize: 10})
+
+			Convey("Then the chunk is reported, with the items created before it", func() {
+				var bulkErr *BulkCreateError
+				So(errors.As(err, &bulkErr), ShouldBeTrue)
+				So(bulkErr.Chunk, ShouldEqual, 1)
+				So(bulkErr.Start, ShouldEqual, 10)
+				So(bulkErr.End, ShouldEqual, 20)
+				So(bulkErr.Created, ShouldHaveLength, 10)
+				So(created, ShouldResemble, bulkErr.Created)
+				So(IsValidation(err), ShouldBeTrue)
+				So(deleted, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a chunk fails and clean up is requested", func() {
+			failChunk = 2
+			created, err := client.Items.CreateBulk(testBoardID, items, BulkCreateOptions{CleanUp: true})
+
+			Convey("Then the items created by the previous chunks are deleted", func() {
+				var bulkErr *BulkCreateError
+				So(errors.As(err, &bulkErr), ShouldBeTrue)
+				So(bulkErr.Chunk, ShouldEqual, 2)
+				So(bulkErr.CleanUpErr, ShouldBeNil)
+				So(created, ShouldBeEmpty)
+				So(deleted, ShouldHaveLength, 40)
+				So(deleted[39], ShouldEqual, "id-39")
+			})
+		})
+
+		Convey("When fewer items than were sent are created", func() {
+			shortChunk = 1
+			created, err := client.Items.CreateBulk(testBoardID, items)
+
+			Convey("Then the chunk is reported, with the items of the chunk that were created", func() {
+				var bulkErr *BulkCreateError
+				So(errors.As(err, &bulkErr), ShouldBeTrue)
+				So(bulkErr.Chunk, ShouldEqual, 1)
+				So(err.Error(), ShouldContainSubstring, "expected 20 items to be created, got 19")
+				So(created, ShouldHaveLength, 39)
+				So(created[38].ID, ShouldEqual, "id-39")
+			})
+		})
+
+		Convey("When fewer items than were sent are created and clean up is requested", func() {
+			shortChunk = 1
+			created, err := client.Items.CreateBulk(testBoardID, items, BulkCreateOptions{CleanUp: true})
+
+			Convey("Then the items of the chunk that were created are deleted too", func() {
+				So(err, ShouldNotBeNil)
+				So(created, ShouldBeEmpty)
+				So(deleted, ShouldHaveLength, 39)
+				So(deleted[38], ShouldEqual, "id-39")
+			})
+		})
+
+		Convey("When the context is cancelled while a chunk is created and clean up is requested", func() {
+			failChunk = 1
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			created, err := client.Items.CreateBulkWithContext(ctx, testBoardID, items, BulkCreateOptions{CleanUp: true})
+
+			Convey("Then the items created by the previous chunks are still deleted", func() {
+				var bulkErr *BulkCreateError
+				So(errors.As(err, &bulkErr), ShouldBeTrue)
+				So(ctx.Err(), ShouldEqual, context.Canceled)
+				So(bulkErr.CleanUpErr, ShouldBeNil)
+				So(created, ShouldBeEmpty)
+				So(deleted, ShouldHaveLength, 20)
+			})
+		})
+
+		Convey("When an invalid item is created by a client that validates payloads", func() {
+			items[3] = NewBulkItem(ItemTypeStickyNote, StickyNoteSet{Style: StickyNoteStyle{FillColor: "purple"}})
+			WithValidation()(client)
+			defer func() { client.validate = false }()
+			_, err := client.Items.CreateBulk(testBoardID, items)
+
+			Convey("Then nothing is created", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "[3].style.fillColor")
+				So(chunks, ShouldBeEmpty)
+			})
+		})
+	})
+}
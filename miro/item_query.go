@@ -0,0 +1,442 @@
+package miro
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// queryPageSize the number of items fetched per page by ItemsService.Query & Tree, the maximum allowed by MIRO
+const queryPageSize = "50"
+
+// ItemQuery a compiled item query, see ParseItemQuery
+type ItemQuery struct {
+	terms []queryTerm
+	// itemType, tag & parentID the predicates that can be pushed down to the MIRO API, tag being a tag's title or ID
+	itemType ItemType
+	tag      string
+	parentID string
+}
+
+// QueryError an error in an item query, with the position it was found at
+type QueryError struct {
+	Query string
+	// Pos the zero-based byte offset of the error in the query
+	Pos int
+	Msg string
+}
+
+// Error reports the position as a one-based column counted in characters, rather than bytes, so that it matches the
+// query as it is displayed
+func (e *QueryError) Error() string {
+	pos := e.Pos
+	if pos > len(e.Query) {
+		pos = len(e.Query)
+	}
+	return fmt.Sprintf("invalid query at column %d: %s", utf8.RuneCountInString(e.Query[:pos])+1, e.Msg)
+}
+
+type queryOperator string
+
+const (
+	queryEquals      queryOperator = ":"
+	queryContains    queryOperator = "~"
+	queryGreater     queryOperator = ">"
+	queryGreaterOrEq queryOperator = ">="
+	queryLess        queryOperator = "<"
+	queryLessOrEq    queryOperator = "<="
+)
+
+type queryTerm struct {
+	field  string
+	op     queryOperator
+	value  string
+	negate bool
+	// pos & valuePos the offsets of the start of the term & its value in the query
+	pos      int
+	valuePos int
+	match    func(item Item) bool
+	// remote the predicate can only be evaluated by the MIRO API (i.e. tag), so match always returns false
+	remote bool
+}
+
+// queryFieldTypes the kind of value each field holds, which decides the operators it supports
+var queryFieldTypes = map[string]string{
+	"type":     "id",
+	"id":       "id",
+	"tag":      "id",
+	"creator":  "id",
+	"modifier": "id",
+	"in":       "id",
+	"text":     "text",
+	"created":  "time",
+	"modified": "time",
+	"x":        "number",
+	"y":        "number",
+	"width":    "number",
+	"height":   "number",
+}
+
+var queryFieldOperators = map[string][]queryOperator{
+	"id":     {queryEquals},
+	"text":   {queryEquals, queryContains},
+	"time":   {queryEquals, queryGreater, queryGreaterOrEq, queryLess, queryLessOrEq},
+	"number": {queryEquals, queryGreater, queryGreaterOrEq, queryLess, queryLessOrEq},
+}
+
+// ParseItemQuery compiles a query that filters board items. A query is a list of terms separated by spaces, all of which
+// must match. Each term is a field, an operator and a value, and can be negated with a leading "-", e.g.
+//
+//	type:sticky_note tag:urgent creator:3458764517517819001 modified>2024-01-01 in:frame:3458764517517819002 text~"budget"
+//
+// The fields are:
+//
+//   - type, id, creator, modifier: the item's type, ID, creator's ID & last modifier's ID (":" only)
+//   - tag: the title of a tag attached to the item, or its ID if no tag on the board has that title (":" only, and can't
+//     be negated)
+//   - in: the ID of the item's parent, optionally prefixed by its type (which can only be frame), e.g. in:frame:<id>
+//     (":" only)
+//   - text: the item's content or title, ignoring case (":" for an exact match, "~" for a partial match)
+//   - created, modified: when the item was created or last modified, as a date (2006-01-02) or an RFC 3339 timestamp
+//   - x, y, width, height: the item's position & size
+//
+// Dates & numbers support the ":", ">", ">=", "<" & "<=" operators. Values containing spaces can be quoted. The type,
+// tag & in predicates are sent to the MIRO API to narrow down the items fetched, the rest are evaluated locally. Items
+// don't hold their tags, so a query with a tag can't be evaluated locally, see Match.
+func ParseItemQuery(query string) (*ItemQuery, error) {
+	p := &queryParser{query: query}
+	q := &ItemQuery{}
+
+	for {
+		p.skipSpaces()
+		if p.pos >= len(query) {
+			break
+		}
+
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		if err := q.addTerm(p, term); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// Match reports whether the item matches every term of the query. Items don't hold their tags, so Match fails closed
+// for a query with a tag: it never matches. Use ItemsService.Query to find the items with a tag.
+func (q *ItemQuery) Match(item Item) bool {
+	for _, term := range q.terms {
+		if term.match(item) == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// matchFetched reports whether an item fetched from the MIRO API matches the query, skipping the terms the MIRO API has
+// already evaluated
+func (q *ItemQuery) matchFetched(item Item) bool {
+	for _, term := range q.terms {
+		if !term.remote && term.match(item) == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the items that match the query, none if the query has a tag, see Match
+func (q *ItemQuery) Filter(items []Item) []Item {
+	var matched []Item
+	for _, item := range items {
+		if q.Match(item) {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// addTerm compile the term's matcher, and record the predicates that can be pushed down to the MIRO API
+func (q *ItemQuery) addTerm(p *queryParser, term queryTerm) error {
+	fieldType := queryFieldTypes[term.field]
+	value := term.value
+
+	switch fieldType {
+	case "id":
+		var get func(item Item) string
+		switch term.field {
+		case "type":
+			get = func(item Item) string { return item.Type }
+			if !term.negate && q.itemType == "" {
+				q.itemType = ItemType(value)
+			}
+		case "id":
+			get = func(item Item) string { return item.ID }
+		case "creator":
+			get = func(item Item) string { return item.CreatedBy.ID }
+		case "modifier":
+			get = func(item Item) string {
+				if item.ModifiedBy == nil {
+					return ""
+				}
+				return item.ModifiedBy.ID
+			}
+		case "in":
+			if parentType, id, ok := strings.Cut(value, ":"); ok {
+				if parentType != string(ItemTypeFrame) {
+					return p.errorf(term.valuePos, "unknown parent type %q, only frames can be parents", parentType)
+				}
+				value = id
+			}
+			get = func(item Item) string {
+				if item.Parent == nil {
+					return ""
+				}
+				return item.Parent.ID
+			}
+			if !term.negate && q.parentID == "" {
+				q.parentID = value
+			}
+		case "tag":
+			if term.negate {
+				return p.errorf(term.pos, "tag can't be negated")
+			}
+			if q.tag != "" {
+				return p.errorf(term.pos, "only one tag can be queried")
+			}
+			q.tag = value
+			term.remote = true
+			term.match = func(item Item) bool { return false }
+			q.terms = append(q.terms, term)
+			return nil
+		}
+		term.match = func(item Item) bool { return get(item) == value }
+
+	case "text":
+		lower := strings.ToLower(value)
+		term.match = func(item Item) bool {
+			for _, text := range []string{item.Data.Content, item.Data.Title} {
+				if term.op == queryContains && strings.Contains(strings.ToLower(text), lower) {
+					return true
+				} else if term.op == queryEquals && strings.EqualFold(text, value) {
+					return true
+				}
+			}
+			return false
+		}
+
+	case "time":
+		from, to, err := parseQueryTime(value)
+		if err != nil {
+			return p.errorf(term.valuePos, "invalid date %q for %s, expected 2006-01-02 or an RFC 3339 timestamp", value, term.field)
+		}
+		get := func(item Item) time.Time { return item.CreatedAt }
+		if term.field == "modified" {
+			get = func(item Item) time.Time { return item.ModifiedAt }
+		}
+		term.match = func(item Item) bool {
+			t := get(item)
+			switch term.op {
+			case queryGreater:
+				return !t.Before(to)
+			case queryGreaterOrEq:
+				return !t.Before(from)
+			case queryLess:
+				return t.Before(from)
+			case queryLessOrEq:
+				return t.Before(to)
+			default:
+				return !t.Before(from) && t.Before(to)
+			}
+		}
+
+	case "number":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return p.errorf(term.valuePos, "invalid number %q for %s", value, term.field)
+		}
+		get := map[string]func(item Item) float64{
+			"x":      func(item Item) float64 { return item.Position.X },
+			"y":      func(item Item) float64 { return item.Position.Y },
+			"width":  func(item Item) float64 { return item.Geometry.Width },
+			"height": func(item Item) float64 { return item.Geometry.Height },
+		}[term.field]
+		term.match = func(item Item) bool {
+			n := get(item)
+			switch term.op {
+			case queryGreater:
+				return n > number
+			case queryGreaterOrEq:
+				return n >= number
+			case queryLess:
+				return n < number
+			case queryLessOrEq:
+				return n <= number
+			default:
+				return n == number
+			}
+		}
+	}
+
+	q.terms = append(q.terms, term)
+	return nil
+}
+
+// resolveQueryTag the ID of the tag with the given title, or with the given ID if no tag has that title, so that
+// titles made only of digits (e.g. 2024) aren't mistaken for IDs
+func resolveQueryTag(ctx context.Context, tags *TagsService, boardID, tag string) (string, error) {
+	var id string
+	err := tags.IterateTagsFromBoardWithContext(ctx, boardID, TagSearchParams{Limit: queryPageSize}).Each(func(t Tag) error {
+		if t.Title == tag {
+			id = t.ID
+			return IteratorDone
+		}
+		if t.ID == tag {
+			id = t.ID
+		}
+		return nil
+	})
+	if err != nil && err != IteratorDone {
+		return "", err
+	}
+	if id == "" {
+		return "", fmt.Errorf("no tag with the title or ID %q on board %s", tag, boardID)
+	}
+	return id, nil
+}
+
+// parseQueryTime parse a date or timestamp as the range of time it covers: a whole day for a date, or an instant for a
+// timestamp
+func parseQueryTime(value string) (time.Time, time.Time, error) {
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date, date.AddDate(0, 0, 1), nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return timestamp, timestamp.Add(time.Nanosecond), nil
+}
+
+type queryParser struct {
+	query string
+	pos   int
+}
+
+func (p *queryParser) errorf(pos int, format string, args ...interface{}) *QueryError {
+	return &QueryError{Query: p.query, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *queryParser) skipSpaces() {
+	for p.pos < len(p.query) && (p.query[p.pos] == ' ' || p.query[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *queryParser) parseTerm() (queryTerm, error) {
+	term := queryTerm{pos: p.pos}
+
+	if p.query[p.pos] == '-' {
+		term.negate = true
+		p.pos++
+	}
+
+	start := p.pos
+	for p.pos < len(p.query) && isQueryFieldChar(p.query[p.pos]) {
+		p.pos++
+	}
+	term.field = strings.ToLower(p.query[start:p.pos])
+	if term.field == "" {
+		return term, p.errorf(start, "expected a field name")
+	}
+	fieldType, ok := queryFieldTypes[term.field]
+	if !ok {
+		return term, p.errorf(start, "unknown field %q", term.field)
+	}
+
+	opPos := p.pos
+	term.op = p.parseOperator()
+	if term.op == "" {
+		return term, p.errorf(opPos, "expected an operator after %s", term.field)
+	}
+	if !containsOperator(queryFieldOperators[fieldType], term.op) {
+		return term, p.errorf(opPos, "operator %q isn't supported by %s", term.op, term.field)
+	}
+
+	valuePos := p.pos
+	value, err := p.parseValue()
+	if err != nil {
+		return term, err
+	}
+	if value == "" {
+		return term, p.errorf(valuePos, "expected a value for %s", term.field)
+	}
+	term.value = value
+	term.valuePos = valuePos
+
+	return term, nil
+}
+
+func (p *queryParser) parseOperator() queryOperator {
+	for _, op := range []queryOperator{queryGreaterOrEq, queryLessOrEq, queryEquals, queryContains, queryGreater, queryLess} {
+		if strings.HasPrefix(p.query[p.pos:], string(op)) {
+			p.pos += len(op)
+			return op
+		}
+	}
+	return ""
+}
+
+// parseValue parse a bare value, which runs to the next space, or a quoted value, which can contain escaped quotes (\")
+// and backslashes (\\)
+func (p *queryParser) parseValue() (string, error) {
+	if p.pos >= len(p.query) || p.query[p.pos] != '"' {
+		start := p.pos
+		for p.pos < len(p.query) && p.query[p.pos] != ' ' && p.query[p.pos] != '\t' {
+			if p.query[p.pos] == '"' {
+				return "", p.errorf(p.pos, "unexpected quote in value")
+			}
+			p.pos++
+		}
+		return p.query[start:p.pos], nil
+	}
+
+	start := p.pos
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.query) {
+		c := p.query[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.query) && (p.query[p.pos+1] == '"' || p.query[p.pos+1] == '\\'):
+			sb.WriteByte(p.query[p.pos+1])
+			p.pos += 2
+		case c == '"':
+			p.pos++
+			if p.pos < len(p.query) && p.query[p.pos] != ' ' && p.query[p.pos] != '\t' {
+				return "", p.errorf(p.pos, "expected a space after the quoted value")
+			}
+			return sb.String(), nil
+		default:
+			sb.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.errorf(start, "unterminated quoted value")
+}
+
+func isQueryFieldChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+func containsOperator(ops []queryOperator, op queryOperator) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
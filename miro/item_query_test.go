@@ -0,0 +1,228 @@
+package miro
+
+import (
+	"encoding/json"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseItemQuery(t *testing.T) {
+	Convey("Given an invalid query", t, func() {
+		tests := []struct {
+			query string
+			pos   int
+			msg   string
+		}{
+			{query: "colour:red", pos: 0, msg: `unknown field "colour"`},
+			{query: "type:card  :foo", pos: 11, msg: "expected a field name"},
+			{query: "type sticky_note", pos: 4, msg: "expected an operator after type"},
+			{query: "type~card", pos: 4, msg: `operator "~" isn't supported by type`},
+			{query: "creator:", pos: 8, msg: "expected a value for creator"},
+			{query: `text~"budget`, pos: 5, msg: "unterminated quoted value"},
+			{query: `text~"budget"2024`, pos: 13, msg: "expected a space after the quoted value"},
+			{query: "modified>yesterday", pos: 9, msg: `invalid date "yesterday" for modified, expected 2006-01-02 or an RFC 3339 timestamp`},
+			{query: "width>=wide", pos: 7, msg: `invalid number "wide" for width`},
+			{query: "type:card -tag:1", pos: 10, msg: "tag can't be negated"},
+			{query: "tag:1 tag:2", pos: 6, msg: "only one tag can be queried"},
+			{query: "in:shape:7", pos: 3, msg: `unknown parent type "shape", only frames can be parents`},
+		}
+
+		for _, test := range tests {
+			Convey("When "+test.query+" is parsed", func() {
+				_, err := ParseItemQuery(test.query)
+
+				Convey("Then an error is returned with the position of the problem", func() {
+					var queryErr *QueryError
+					So(errors.As(err, &queryErr), ShouldBeTrue)
+					So(queryErr.Query, ShouldEqual, test.query)
+					So(queryErr.Pos, ShouldEqual, test.pos)
+					So(queryErr.Msg, ShouldEqual, test.msg)
+				})
+			})
+		}
+	})
+
+	Convey("Given a query with characters made up of several bytes before an error", t, func() {
+		_, err := ParseItemQuery(`text:"café ☕" width>=wide`)
+
+		Convey("Then the error's column is counted in characters, while Pos is a byte offset", func() {
+			var queryErr *QueryError
+			So(errors.As(err, &queryErr), ShouldBeTrue)
+			So(queryErr.Pos, ShouldEqual, 24)
+			So(err.Error(), ShouldEqual, `invalid query at column 22: invalid number "wide" for width`)
+		})
+	})
+
+	Convey("Given a query with predicates that can be sent to the MIRO API", t, func() {
+		Convey("When it is parsed", func() {
+			query, err := ParseItemQuery(`type:sticky_note  tag:42 in:frame:7 text~"quarterly \"budget\""`)
+
+			Convey("Then the predicates are pushed down", func() {
+				So(err, ShouldBeNil)
+				So(query.itemType, ShouldEqual, ItemTypeStickyNote)
+				So(query.tag, ShouldEqual, "42")
+				So(query.parentID, ShouldEqual, "7")
+				So(query.terms[3].value, ShouldEqual, `quarterly "budget"`)
+			})
+		})
+
+		Convey("When the predicates are negated", func() {
+			query, err := ParseItemQuery("-type:frame -in:7")
+
+			Convey("Then they are only evaluated locally", func() {
+				So(err, ShouldBeNil)
+				So(query.itemType, ShouldBeEmpty)
+				So(query.parentID, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestItemQueryMatch(t *testing.T) {
+	item := Item{
+		ID:         "1",
+		Type:       "sticky_note",
+		CreatedBy:  BasicEntityInfo{ID: "100"},
+		ModifiedBy: &BasicEntityInfo{ID: "101"},
+		CreatedAt:  time.Date(2023, 12, 31, 9, 0, 0, 0, time.UTC),
+		ModifiedAt: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+		Parent:     &Parent{ID: "7"},
+		Data:       ItemData{Content: "<p>Budget for Q1</p>"},
+		Position:   Position{X: 10, Y: -20},
+		Geometry:   Geometry{Width: 200, Height: 100},
+	}
+
+	tests := []struct {
+		query    string
+		expected bool
+	}{
+		{query: "", expected: true},
+		{query: "type:sticky_note", expected: true},
+		{query: "-type:sticky_note", expected: false},
+		{query: "id:1 creator:100 modifier:101", expected: true},
+		{query: "creator:101", expected: false},
+		{query: "in:frame:7", expected: true},
+		{query: "in:8", expected: false},
+		{query: `text~"budget for"`, expected: true},
+		{query: "text:budget", expected: false},
+		{query: "created<2024-01-01 modified>2024-01-01", expected: true},
+		{query: "modified:2024-01-15", expected: true},
+		{query: "modified>2024-01-15", expected: false},
+		{query: "modified<=2024-01-15", expected: true},
+		{query: "modified>=2024-01-15T09:00:00Z", expected: true},
+		{query: "modified>2024-01-15T09:00:00Z", expected: false},
+		{query: "x:10 y<0 width>=200 height<100", expected: false},
+		{query: "x:10 y<0 width>=200 height<=100", expected: true},
+		{query: "tag:42", expected: false},
+	}
+
+	Convey("Given an item", t, func() {
+		for _, test := range tests {
+			Convey("When it is matched against the query "+test.query, func() {
+				query, err := ParseItemQuery(test.query)
+				So(err, ShouldBeNil)
+
+				Convey("Then the result is as expected", func() {
+					So(query.Match(item), ShouldEqual, test.expected)
+				})
+			})
+		}
+
+		Convey("When a slice of items is filtered", func() {
+			query, err := ParseItemQuery("-in:7")
+			So(err, ShouldBeNil)
+			items := query.Filter([]Item{item, {ID: "2"}, {ID: "3", Parent: &Parent{ID: "8"}}})
+
+			Convey("Then only the matching items are returned", func() {
+				So(itemIDs(items), ShouldResemble, []string{"2", "3"})
+			})
+		})
+
+		Convey("When a slice of items is filtered by tag", func() {
+			query, err := ParseItemQuery("tag:urgent")
+			So(err, ShouldBeNil)
+			items := query.Filter([]Item{item, {ID: "2"}})
+
+			Convey("Then no items are returned, as items don't hold their tags", func() {
+				So(items, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestItemsQuery(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+
+	var requests []string
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		response := ListItems{Data: []Item{
+			{ID: "1", Type: "card", Parent: &Parent{ID: "7"}, CreatedBy: BasicEntityInfo{ID: "100"}},
+			{ID: "2", Type: "card", Parent: &Parent{ID: "7"}, CreatedBy: BasicEntityInfo{ID: "200"}},
+			{ID: "3", Type: "card", Parent: &Parent{ID: "7"}, CreatedBy: BasicEntityInfo{ID: "100"}},
+		}, Size: 3, Total: 3}
+		json.NewEncoder(w).Encode(response)
+	})
+	mux.HandleFunc(strings.TrimSuffix(testResourcePath, "items")+"tags", func(w http.ResponseWriter, r *http.Request) {
+		response := ListBoardTags{Data: []Tag{{ID: "41", Title: "later"}, {ID: "42", Title: "urgent"}, {ID: "43", Title: "2024"},
+			{ID: "44", Title: "41"}}, Size: 4, Total: 4}
+		json.NewEncoder(w).Encode(response)
+	})
+
+	Convey("Given a board with items created by different users", t, func() {
+		requests = nil
+
+		tests := []struct {
+			query   string
+			request string
+		}{
+			{query: "type:card creator:100", request: "limit=50&type=card"},
+			{query: "in:frame:7 creator:100", request: "limit=50&parent_item_id=7"},
+			{query: "tag:42 type:card creator:100", request: "limit=50&tag_id=42"},
+			{query: "tag:urgent type:card creator:100", request: "limit=50&tag_id=42"},
+			{query: "tag:2024 type:card creator:100", request: "limit=50&tag_id=43"},
+			{query: "tag:41 type:card creator:100", request: "limit=50&tag_id=44"},
+		}
+
+		for _, test := range tests {
+			Convey("When the items are queried with "+test.query, func() {
+				query, err := ParseItemQuery(test.query)
+				So(err, ShouldBeNil)
+				items, err := client.Items.Query(testBoardID, query).All()
+
+				Convey("Then the supported predicates are sent to MIRO and the rest are evaluated locally", func() {
+					So(err, ShouldBeNil)
+					So(requests, ShouldResemble, []string{test.request})
+					So(itemIDs(items), ShouldResemble, []string{"1", "3"})
+				})
+			})
+		}
+
+		Convey("When the items are queried with a tag that isn't on the board", func() {
+			query, err := ParseItemQuery("tag:someday")
+			So(err, ShouldBeNil)
+			_, err = client.Items.Query(testBoardID, query).All()
+
+			Convey("Then an error is returned without fetching any items", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `no tag with the title or ID "someday"`)
+				So(requests, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the items are queried without a query", func() {
+			items, err := client.Items.Query(testBoardID, nil).All()
+
+			Convey("Then every item is returned", func() {
+				So(err, ShouldBeNil)
+				So(requests, ShouldResemble, []string{"limit=50"})
+				So(itemIDs(items), ShouldResemble, []string{"1", "2", "3"})
+			})
+		})
+	})
+}
@@ -0,0 +1,147 @@
+package miro
+
+import (
+	"context"
+	"errors"
+)
+
+// SkipChildren returned by a WalkFunc to skip the children of the node it was called with
+var SkipChildren = errors.New("skip the children of this node")
+
+// ItemNode an item in a BoardTree, along with the items within it
+type ItemNode struct {
+	Item     Item        `json:"item"`
+	Children []*ItemNode `json:"children,omitempty"`
+	// Parent the node of the item this item is within, nil for the items at the top of the tree
+	Parent *ItemNode `json:"-"`
+	// Depth the depth of the node in the tree, the items at the top of the tree are at depth 1
+	Depth int `json:"depth"`
+}
+
+// BoardTree the items on a board arranged by parent: the items on the canvas (or within the item the tree was built
+// from), each with the items within it
+type BoardTree struct {
+	BoardID string      `json:"boardId"`
+	Items   []*ItemNode `json:"items"`
+	nodes   map[string]*ItemNode
+}
+
+// TreeOptions controls how a BoardTree is built
+type TreeOptions struct {
+	// ParentItemID only build the tree of the items within this item (e.g. a frame), rather than of the whole board
+	ParentItemID string
+	// MaxDepth the depth of the deepest items included in the tree, e.g. 1 for just the items on the canvas. Zero means
+	// no limit.
+	MaxDepth int
+}
+
+// WalkFunc called by BoardTree.Walk with each node of the tree. Returning SkipChildren skips the children of the node,
+// any other error stops the walk and is returned by Walk.
+type WalkFunc func(node *ItemNode) error
+
+// Find returns the node of the item with the given ID, or nil if the item isn't in the tree
+func (t *BoardTree) Find(itemID string) *ItemNode {
+	return t.nodes[itemID]
+}
+
+// Walk calls fn with each node of the tree, depth-first, visiting each node before its children
+func (t *BoardTree) Walk(fn WalkFunc) error {
+	return walkNodes(t.Items, fn)
+}
+
+func walkNodes(nodes []*ItemNode, fn WalkFunc) error {
+	for _, node := range nodes {
+		if err := fn(node); err == SkipChildren {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := walkNodes(node.Children, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add a node for the item to the tree, under the given parent, unless it would be deeper than maxDepth
+func (t *BoardTree) add(item Item, parent *ItemNode, maxDepth int) *ItemNode {
+	node := &ItemNode{Item: item, Parent: parent, Depth: 1}
+	if parent != nil {
+		node.Depth = parent.Depth + 1
+	}
+	if maxDepth > 0 && node.Depth > maxDepth {
+		return nil
+	}
+
+	if parent == nil {
+		t.Items = append(t.Items, node)
+	} else {
+		parent.Children = append(parent.Children, node)
+	}
+	t.nodes[item.ID] = node
+	return node
+}
+
+// buildBoardTree build the tree of a whole board from a single pass over its items, attaching each item to its parent.
+// Items whose parent isn't on the board are placed at the top of the tree.
+func buildBoardTree(boardID string, items []Item, maxDepth int) *BoardTree {
+	tree := &BoardTree{BoardID: boardID, nodes: make(map[string]*ItemNode)}
+
+	children := make(map[string][]Item)
+	ids := make(map[string]bool, len(items))
+	for _, item := range items {
+		ids[item.ID] = true
+	}
+
+	var roots []Item
+	for _, item := range items {
+		if item.Parent != nil && item.Parent.ID != "" && ids[item.Parent.ID] {
+			children[item.Parent.ID] = append(children[item.Parent.ID], item)
+		} else {
+			roots = append(roots, item)
+		}
+	}
+
+	var attach func(items []Item, parent *ItemNode)
+	attach = func(items []Item, parent *ItemNode) {
+		for _, item := range items {
+			if node := tree.add(item, parent, maxDepth); node != nil {
+				attach(children[item.ID], node)
+			}
+		}
+	}
+	attach(roots, nil)
+
+	return tree
+}
+
+// buildSubtree build the tree of the items within a parent item, fetching the items within each frame in turn
+func buildSubtree(ctx context.Context, boardID string, options TreeOptions, iterate func(ctx context.Context, parentID string) *Iterator[Item]) (*BoardTree, error) {
+	tree := &BoardTree{BoardID: boardID, nodes: make(map[string]*ItemNode)}
+
+	var fetch func(parentID string, parent *ItemNode) error
+	fetch = func(parentID string, parent *ItemNode) error {
+		items, err := iterate(ctx, parentID).All()
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			node := tree.add(item, parent, options.MaxDepth)
+			// only frames can contain other items, and there's no need to fetch items that would be too deep
+			if node == nil || item.Type != string(ItemTypeFrame) || (options.MaxDepth > 0 && node.Depth >= options.MaxDepth) {
+				continue
+			}
+			if err := fetch(item.ID, node); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := fetch(options.ParentItemID, nil); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
@@ -0,0 +1,157 @@
+package miro
+
+import (
+	"encoding/json"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"testing"
+)
+
+// mockBoardTree serve a board with a sticky note on the canvas and a frame holding a nested frame & a card, filtering
+// the items by parent_item_id when it is sent
+func mockBoardTree(requests *[]string) http.HandlerFunc {
+	items := []Item{
+		{ID: "note", Type: "sticky_note"},
+		{ID: "frame-1", Type: "frame"},
+		{ID: "frame-2", Type: "frame", Parent: &Parent{ID: "frame-1"}},
+		{ID: "card", Type: "card", Parent: &Parent{ID: "frame-2"}},
+		{ID: "text", Type: "text", Parent: &Parent{ID: "frame-1"}},
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.Query().Get("parent_item_id"))
+
+		response := ListItems{}
+		parentID := r.URL.Query().Get("parent_item_id")
+		for _, item := range items {
+			if parentID == "" || (item.Parent != nil && item.Parent.ID == parentID) {
+				response.Data = append(response.Data, item)
+			}
+		}
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+// treeIDs the IDs of the nodes of the tree, in the order they are walked, with each ID prefixed by its depth
+func treeIDs(tree *BoardTree) []string {
+	var ids []string
+	tree.Walk(func(node *ItemNode) error {
+		ids = append(ids, string(rune('0'+node.Depth))+":"+node.Item.ID)
+		return nil
+	})
+	return ids
+}
+
+func TestItemsTree(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+
+	var requests []string
+	mux.HandleFunc(testResourcePath, mockBoardTree(&requests))
+
+	Convey("Given a board with nested frames", t, func() {
+		requests = nil
+
+		Convey("When the tree of the whole board is built", func() {
+			tree, err := client.Items.Tree(testBoardID)
+
+			Convey("Then each item is placed under its parent from a single pass over the items", func() {
+				So(err, ShouldBeNil)
+				So(requests, ShouldResemble, []string{""})
+				So(treeIDs(tree), ShouldResemble, []string{"1:note", "1:frame-1", "2:frame-2", "3:card", "2:text"})
+				So(tree.Find("card").Parent.Item.ID, ShouldEqual, "frame-2")
+				So(tree.Find("missing"), ShouldBeNil)
+			})
+		})
+
+		Convey("When the tree is built with a max depth", func() {
+			tree, err := client.Items.Tree(testBoardID, TreeOptions{MaxDepth: 2})
+
+			Convey("Then the deeper items are left out", func() {
+				So(err, ShouldBeNil)
+				So(treeIDs(tree), ShouldResemble, []string{"1:note", "1:frame-1", "2:frame-2", "2:text"})
+				So(tree.Find("card"), ShouldBeNil)
+			})
+		})
+
+		Convey("When the tree within a frame is built", func() {
+			tree, err := client.Items.Tree(testBoardID, TreeOptions{ParentItemID: "frame-1"})
+
+			Convey("Then the items within each frame are fetched by parent", func() {
+				So(err, ShouldBeNil)
+				So(requests, ShouldResemble, []string{"frame-1", "frame-2"})
+				So(treeIDs(tree), ShouldResemble, []string{"1:frame-2", "2:card", "1:text"})
+			})
+		})
+
+		Convey("When the tree within a frame is built with a max depth", func() {
+			tree, err := client.Items.Tree(testBoardID, TreeOptions{ParentItemID: "frame-1", MaxDepth: 1})
+
+			Convey("Then the frames beyond the max depth aren't fetched", func() {
+				So(err, ShouldBeNil)
+				So(requests, ShouldResemble, []string{"frame-1"})
+				So(treeIDs(tree), ShouldResemble, []string{"1:frame-2", "1:text"})
+			})
+		})
+	})
+}
+
+func TestBoardTreeWalk(t *testing.T) {
+	tree := buildBoardTree(testBoardID, []Item{
+		{ID: "frame-1", Type: "frame"},
+		{ID: "card", Type: "card", Parent: &Parent{ID: "frame-1"}},
+		{ID: "frame-2", Type: "frame"},
+		{ID: "orphan", Type: "text", Parent: &Parent{ID: "deleted"}},
+	}, 0)
+
+	Convey("Given a board tree", t, func() {
+		Convey("When the visitor skips the children of a frame", func() {
+			var ids []string
+			err := tree.Walk(func(node *ItemNode) error {
+				ids = append(ids, node.Item.ID)
+				if node.Item.ID == "frame-1" {
+					return SkipChildren
+				}
+				return nil
+			})
+
+			Convey("Then the rest of the tree is still walked", func() {
+				So(err, ShouldBeNil)
+				So(ids, ShouldResemble, []string{"frame-1", "frame-2", "orphan"})
+			})
+		})
+
+		Convey("When the visitor returns an error", func() {
+			var ids []string
+			err := tree.Walk(func(node *ItemNode) error {
+				ids = append(ids, node.Item.ID)
+				return errors.New("stop")
+			})
+
+			Convey("Then the walk stops and the error is returned", func() {
+				So(err, ShouldBeError, "stop")
+				So(ids, ShouldResemble, []string{"frame-1"})
+			})
+		})
+
+		Convey("When the tree is encoded as JSON", func() {
+			data, err := json.Marshal(tree)
+
+			Convey("Then the children are nested under their parents", func() {
+				So(err, ShouldBeNil)
+				var decoded struct {
+					Items []struct {
+						Item     Item `json:"item"`
+						Children []struct {
+							Item Item `json:"item"`
+						} `json:"children"`
+					} `json:"items"`
+				}
+				So(json.Unmarshal(data, &decoded), ShouldBeNil)
+				So(decoded.Items, ShouldHaveLength, 3)
+				So(decoded.Items[0].Children[0].Item.ID, ShouldEqual, "card")
+			})
+		})
+	})
+}
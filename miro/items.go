@@ -1,5 +1,11 @@
 package miro
 
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
 type ItemsService struct {
 	client      *Client
 	apiVersion  string
@@ -20,43 +26,247 @@ type ItemsService struct {
 // cursor parameter value to foo.
 // Required scope: boards:read | Rate limiting: Level 2
 func (i *ItemsService) GetAll(boardID string, queryParams ...ItemSearchParams) (*ListItems, error) {
+	return i.GetAllWithContext(i.client.ctx, boardID, queryParams...)
+}
+
+// GetAllWithContext GetAll using the given context, which can be used to cancel the request or set a deadline.
+func (i *ItemsService) GetAllWithContext(ctx context.Context, boardID string, queryParams ...ItemSearchParams) (*ListItems, error) {
 	response := &ListItems{}
 
 	if url, err := constructURL(i.client.BaseURL, i.apiVersion, i.resource, boardID, i.subResource); err != nil {
 		return response, err
 	} else {
+		var searchParams []Parameter
 		if len(queryParams) > 0 {
-			err = i.client.Get(i.client.ctx, url, response, parseQueryTags(queryParams[0])...)
-		} else {
-			err = i.client.Get(i.client.ctx, url, response)
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
 		}
+		err = i.client.Get(withRateLimitLevel(ctx, RateLimitLevel2), url, response, searchParams...)
 
 		return response, err
 	}
 }
 
+// Iterate returns an iterator over all the items on a board that match the search criteria, fetching the pages of
+// results as they are needed. The Cursor of the search params is used as the starting point.
+// Required scope: boards:read | Rate limiting: Level 2 (per page)
+func (i *ItemsService) Iterate(boardID string, queryParams ...ItemSearchParams) *Iterator[Item] {
+	return i.IterateWithContext(i.client.ctx, boardID, queryParams...)
+}
+
+// IterateWithContext Iterate using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (i *ItemsService) IterateWithContext(ctx context.Context, boardID string, queryParams ...ItemSearchParams) *Iterator[Item] {
+	var params ItemSearchParams
+	if len(queryParams) > 0 {
+		params = queryParams[0]
+	}
+
+	iter := newIterator(ctx, func(ctx context.Context, cursor string) ([]Item, string, error) {
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+		response, err := i.GetAllWithContext(ctx, boardID, params)
+		return response.Data, response.Cursor, err
+	})
+	iter.next = params.Cursor
+	return iter
+}
+
+// Query returns an iterator over the items on a board that match the query, see ParseItemQuery. The type, tag & in
+// predicates are sent to the MIRO API to narrow down the items fetched, the rest are evaluated on each page of results.
+// The tag is looked up by its title (or ID) in the tags of the board first. A nil query matches every item.
+// Required scope: boards:read | Rate limiting: Level 2 (per page), or Level 1 (per page) when querying by tag
+func (i *ItemsService) Query(boardID string, query *ItemQuery) *Iterator[Item] {
+	return i.QueryWithContext(i.client.ctx, boardID, query)
+}
+
+// QueryWithContext Query using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (i *ItemsService) QueryWithContext(ctx context.Context, boardID string, query *ItemQuery) *Iterator[Item] {
+	if query == nil {
+		query = &ItemQuery{}
+	}
+	var iter *Iterator[Item]
+	switch {
+	case query.tag != "":
+		// the tag is resolved to its ID when the first page is fetched
+		var tagged *Iterator[Item]
+		iter = newIterator(ctx, func(ctx context.Context, next string) ([]Item, string, error) {
+			if tagged == nil {
+				tagID, err := resolveQueryTag(ctx, i.client.Tags, boardID, query.tag)
+				if err != nil {
+					return nil, "", err
+				}
+				tagged = i.client.Tags.IterateTagsWithContext(ctx, boardID, tagID, TagSearchParams{Limit: queryPageSize})
+			}
+			return tagged.fetch(ctx, next)
+		})
+	default:
+		params := ItemSearchParams{Type: query.itemType, ParentItemID: query.parentID, Limit: queryPageSize}
+		iter = i.IterateWithContext(ctx, boardID, params)
+	}
+	return iter.filter(query.matchFetched)
+}
+
+// Tree returns the items on a board arranged by parent, i.e. the items on the canvas, each with the items within it. The
+// tree of the whole board is built from a single pass over its items, while the tree within a parent item is built by
+// fetching the items within each frame.
+// Required scope: boards:read | Rate limiting: Level 2 (per page)
+func (i *ItemsService) Tree(boardID string, options ...TreeOptions) (*BoardTree, error) {
+	return i.TreeWithContext(i.client.ctx, boardID, options...)
+}
+
+// TreeWithContext Tree using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (i *ItemsService) TreeWithContext(ctx context.Context, boardID string, options ...TreeOptions) (*BoardTree, error) {
+	var opts TreeOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if opts.ParentItemID != "" {
+		return buildSubtree(ctx, boardID, opts, func(ctx context.Context, parentID string) *Iterator[Item] {
+			return i.IterateWithContext(ctx, boardID, ItemSearchParams{ParentItemID: parentID, Limit: queryPageSize})
+		})
+	}
+
+	items, err := i.IterateWithContext(ctx, boardID, ItemSearchParams{Limit: queryPageSize}).All()
+	if err != nil {
+		return nil, err
+	}
+	return buildBoardTree(boardID, items, opts.MaxDepth), nil
+}
+
+// CreateBulk creates items of any type on a board, in chunks of up to 20 items, each created in a single request. The
+// items created are returned in the same order as the input. If a chunk fails a *BulkCreateError is returned, with the
+// items created by the previous chunks (which are deleted first if the CleanUp option is set). The clean up isn't
+// stopped by the context being cancelled or its deadline passing.
+// Required scope: boards:write | Rate limiting: Level 2 (per chunk), Level 3 (per item cleaned up)
+func (i *ItemsService) CreateBulk(boardID string, items []BulkItem, options ...BulkCreateOptions) ([]Item, error) {
+	return i.CreateBulkWithContext(i.client.ctx, boardID, items, options...)
+}
+
+// CreateBulkWithContext CreateBulk using the given context, which can be used to cancel the request or set a deadline.
+func (i *ItemsService) CreateBulkWithContext(ctx context.Context, boardID string, items []BulkItem, options ...BulkCreateOptions) ([]Item, error) {
+	var opts BulkCreateOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	if opts.ChunkSize <= 0 || opts.ChunkSize > maxBulkItems {
+		opts.ChunkSize = maxBulkItems
+	}
+
+	url, err := constructURL(i.client.BaseURL, i.apiVersion, i.resource, boardID, i.subResource, "bulk")
+	if err != nil {
+		return nil, err
+	}
+	if i.client.validate {
+		// validate every chunk up front, rather than failing part way through
+		if err := validateBulkItems(items, APIVersion(i.apiVersion)); err != nil {
+			return nil, err
+		}
+	}
+
+	created := make([]Item, 0, len(items))
+	for start := 0; start < len(items); start += opts.ChunkSize {
+		end := start + opts.ChunkSize
+		if end > len(items) {
+			end = len(items)
+		}
+
+		response := &ListItems{}
+		err := i.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, items[start:end], response)
+		if err == nil && len(response.Data) != end-start {
+			// the items that were created still have to be reported (and cleaned up)
+			created = append(created, response.Data...)
+			err = fmt.Errorf("expected %d items to be created, got %d", end-start, len(response.Data))
+		}
+		if err != nil {
+			bulkErr := &BulkCreateError{Chunk: start / opts.ChunkSize, Start: start, End: end, Created: created, Err: err}
+			if opts.CleanUp {
+				// the chunk may have failed because the context was cancelled, which mustn't stop the clean up
+				bulkErr.Created, bulkErr.CleanUpErr = i.deleteItems(withoutCancel(ctx), boardID, created)
+			}
+			return bulkErr.Created, bulkErr
+		}
+		created = append(created, response.Data...)
+	}
+	return created, nil
+}
+
+// detachedContext a context with the values of its parent that is never cancelled & has no deadline
+type detachedContext struct {
+	parent context.Context
+}
+
+// withoutCancel a copy of the context that isn't cancelled when it is, as context.WithoutCancel isn't available before
+// Go 1.21
+func withoutCancel(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
+
+func (d detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (d detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (d detachedContext) Err() error {
+	return nil
+}
+
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
+
+// deleteItems delete the items, returning those that couldn't be deleted along with the first error
+func (i *ItemsService) deleteItems(ctx context.Context, boardID string, items []Item) ([]Item, error) {
+	var remaining []Item
+	var firstErr error
+	for _, item := range items {
+		if err := i.DeleteWithContext(ctx, boardID, item.ID); err != nil {
+			remaining = append(remaining, item)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return remaining, firstErr
+}
+
 // Get information for a specific item on a board.
 // Required scope: boards:read | Rate limiting: Level 1
 func (i *ItemsService) Get(boardID, itemID string) (*Item, error) {
+	return i.GetWithContext(i.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (i *ItemsService) GetWithContext(ctx context.Context, boardID, itemID string) (*Item, error) {
 	response := &Item{}
 
 	if url, err := constructURL(i.client.BaseURL, i.apiVersion, i.resource, boardID, i.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = i.client.Get(i.client.ctx, url, response)
+		err = i.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update item position or parent
 // Required scope: boards:write | Rate limiting: Level 2
-func (i *ItemsService) Update(boardID, itemID string, payload ItemUpdate) (*Item, error) {
+func (i *ItemsService) Update(boardID, itemID string, payload ItemUpdate, fields ...PatchField) (*Item, error) {
+	return i.UpdateWithContext(i.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (i *ItemsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload ItemUpdate, fields ...PatchField) (*Item, error) {
 	response := &Item{}
 
 	if url, err := constructURL(i.client.BaseURL, i.apiVersion, i.resource, boardID, i.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = i.client.Patch(i.client.ctx, url, payload, response)
+		err = i.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -64,9 +274,14 @@ func (i *ItemsService) Update(boardID, itemID string, payload ItemUpdate) (*Item
 // Delete an item from a board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (i *ItemsService) Delete(boardID, itemID string) error {
+	return i.DeleteWithContext(i.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (i *ItemsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(i.client.BaseURL, i.apiVersion, i.resource, boardID, i.subResource, itemID); err != nil {
 		return err
 	} else {
-		return i.client.Delete(i.client.ctx, url)
+		return i.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
@@ -1,6 +1,9 @@
 package miro
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type ItemType string
 
@@ -29,6 +32,9 @@ type ItemSearchParams struct {
 	// cursor that points to the next portion of the results. To retrieve the next portion of the collection, set the cursor
 	// parameter equal to the cursor value you received in the response of the previous request.
 	Cursor string `query:"cursor,omitempty"`
+	// ParentItemID If you want to get a list of the items within a specific parent item (e.g. a frame), specify the ID
+	// of the parent item.
+	ParentItemID string `query:"parent_item_id,omitempty"`
 }
 
 type ItemData struct {
@@ -38,6 +44,17 @@ type ItemData struct {
 	Type        string `json:"type,omitempty"`
 	Content     string `json:"content,omitempty"`
 	Shape       string `json:"shape,omitempty"`
+	Extra       Extra  `json:"-"`
+}
+
+func (i *ItemData) UnmarshalJSON(data []byte) error {
+	type itemData ItemData
+	return unmarshalWithExtra(data, (*itemData)(i), &i.Extra)
+}
+
+func (i ItemData) MarshalJSON() ([]byte, error) {
+	type itemData ItemData
+	return marshalWithExtra(itemData(i), i.Extra)
 }
 
 type Item struct {
@@ -53,6 +70,23 @@ type Item struct {
 	Position   Position         `json:"position"`
 	Style      Style            `json:"style"`
 	Type       string           `json:"type"`
+	Extra      Extra            `json:"-"`
+	// raw the JSON the item was decoded from, used by Decode
+	raw json.RawMessage
+}
+
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type item Item
+	if err := unmarshalWithExtra(data, (*item)(i), &i.Extra); err != nil {
+		return err
+	}
+	i.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+func (i Item) MarshalJSON() ([]byte, error) {
+	type item Item
+	return marshalWithExtra(item(i), i.Extra)
 }
 
 type ListItems struct {
@@ -60,9 +94,21 @@ type ListItems struct {
 	Total  int             `json:"total"`
 	Size   int             `json:"size"`
 	Cursor string          `json:"cursor,omitempty"`
+	Offset int             `json:"offset,omitempty"`
 	Limit  int             `json:"limit"`
 	Links  PaginationLinks `json:"links"`
 	Type   string          `json:"type"`
+	Extra  Extra           `json:"-"`
+}
+
+func (l *ListItems) UnmarshalJSON(data []byte) error {
+	type listItems ListItems
+	return unmarshalWithExtra(data, (*listItems)(l), &l.Extra)
+}
+
+func (l ListItems) MarshalJSON() ([]byte, error) {
+	type listItems ListItems
+	return marshalWithExtra(listItems(l), l.Extra)
 }
 
 type ItemUpdate struct {
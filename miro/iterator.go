@@ -0,0 +1,119 @@
+package miro
+
+import (
+	"context"
+	"errors"
+)
+
+// IteratorDone returned by the iterators (and ListBoards.GetNext) once there are no more results
+var IteratorDone = errors.New("no more results")
+
+// Iterator iterates over every item of a paginated list, fetching the pages as they are needed, e.g.
+//
+//	iter := client.Items.Iterate(boardID, miro.ItemSearchParams{Type: miro.ItemTypeStickyNote})
+//	for {
+//		item, err := iter.Next()
+//		if err == miro.IteratorDone {
+//			break
+//		} else if err != nil {
+//			return err
+//		}
+//		fmt.Println(item.ID)
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, next string) ([]T, string, error)
+	page  []T
+	// next the cursor (or URL) of the next page, empty once the last page has been fetched
+	next     string
+	started  bool
+	maxItems int
+	returned int
+}
+
+// newIterator create an iterator that fetches each page with fetch, passing the cursor (or URL) of the next page
+// returned with the previous page, or an empty string for the first page
+func newIterator[T any](ctx context.Context, fetch func(ctx context.Context, next string) ([]T, string, error)) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch}
+}
+
+// filter only return the items for which match returns true. Pages with no matching items are skipped.
+func (it *Iterator[T]) filter(match func(item T) bool) *Iterator[T] {
+	fetch := it.fetch
+	it.fetch = func(ctx context.Context, next string) ([]T, string, error) {
+		page, next, err := fetch(ctx, next)
+		var matched []T
+		for _, item := range page {
+			if match(item) {
+				matched = append(matched, item)
+			}
+		}
+		return matched, next, err
+	}
+	return it
+}
+
+// MaxItems caps the number of items returned by the iterator, zero (the default) means no cap. Pages are only fetched
+// as they are needed, so no pages beyond the one holding the last item are fetched.
+func (it *Iterator[T]) MaxItems(max int) *Iterator[T] {
+	it.maxItems = max
+	return it
+}
+
+// Next returns the next item, fetching the next page first if needed. IteratorDone is returned once every item (or
+// MaxItems items) has been returned. If a page can't be fetched, its error is returned and the next call to Next tries
+// to fetch the same page again.
+func (it *Iterator[T]) Next() (T, error) {
+	var item T
+
+	if it.maxItems > 0 && it.returned >= it.maxItems {
+		return item, IteratorDone
+	}
+	if err := it.ctx.Err(); err != nil {
+		return item, err
+	}
+
+	for len(it.page) == 0 {
+		if it.started && it.next == "" {
+			return item, IteratorDone
+		}
+
+		page, next, err := it.fetch(it.ctx, it.next)
+		if err != nil {
+			return item, err
+		}
+		it.started = true
+		it.page, it.next = page, next
+	}
+
+	item, it.page = it.page[0], it.page[1:]
+	it.returned++
+	return item, nil
+}
+
+// All returns all the remaining items. If a page can't be fetched, the items returned so far are returned along with
+// the error.
+func (it *Iterator[T]) All() ([]T, error) {
+	var items []T
+	err := it.Each(func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// Each calls fn with each of the remaining items, stopping at the first error returned by fn or by the iterator
+func (it *Iterator[T]) Each(fn func(item T) error) error {
+	for {
+		item, err := it.Next()
+		if err == IteratorDone {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// mockCursorPages serve items in pages of 2, using the index of the next item as the cursor
+func mockCursorPages(totalItems int, failAt string, requests *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		*requests = append(*requests, cursor)
+		if cursor == failAt {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		start, _ := strconv.Atoi(cursor)
+		response := ListItems{}
+		for i := start; i < start+2 && i < totalItems; i++ {
+			response.Data = append(response.Data, Item{ID: fmt.Sprintf("item-%d", i)})
+		}
+		if start+2 < totalItems {
+			response.Cursor = strconv.Itoa(start + 2)
+		}
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+func itemIDs(items []Item) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestItemsIterate(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+
+	var requests []string
+	failAt := "never"
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mockCursorPages(5, failAt, &requests)(w, r)
+	})
+
+	Convey("Given a board with 5 items, returned 2 per page", t, func() {
+		requests, failAt = nil, "never"
+
+		Convey("When all the items are requested from the iterator", func() {
+			items, err := client.Items.Iterate(testBoardID, ItemSearchParams{Type: ItemTypeStickyNote}).All()
+
+			Convey("Then every item is returned and each page is fetched once", func() {
+				So(err, ShouldBeNil)
+				So(itemIDs(items), ShouldResemble, []string{"item-0", "item-1", "item-2", "item-3", "item-4"})
+				So(requests, ShouldResemble, []string{"", "2", "4"})
+			})
+		})
+
+		Convey("When the iterator is capped at 3 items", func() {
+			items, err := client.Items.Iterate(testBoardID).MaxItems(3).All()
+
+			Convey("Then only 3 items are returned and no more pages than needed are fetched", func() {
+				So(err, ShouldBeNil)
+				So(itemIDs(items), ShouldResemble, []string{"item-0", "item-1", "item-2"})
+				So(requests, ShouldResemble, []string{"", "2"})
+			})
+		})
+
+		Convey("When the iterator starts from a cursor", func() {
+			items, err := client.Items.Iterate(testBoardID, ItemSearchParams{Cursor: "2"}).All()
+
+			Convey("Then the items from the cursor onwards are returned", func() {
+				So(err, ShouldBeNil)
+				So(itemIDs(items), ShouldResemble, []string{"item-2", "item-3", "item-4"})
+			})
+		})
+
+		Convey("When the second page fails", func() {
+			failAt = "2"
+			iter := client.Items.Iterate(testBoardID)
+			items, err := iter.All()
+
+			Convey("Then the items of the first page are returned along with the error", func() {
+				So(itemIDs(items), ShouldResemble, []string{"item-0", "item-1"})
+				So(IsRetryable(err), ShouldBeTrue)
+
+				Convey("And the failed page is fetched again by the next call to Next", func() {
+					failAt = "never"
+					item, err := iter.Next()
+					So(err, ShouldBeNil)
+					So(item.ID, ShouldEqual, "item-2")
+				})
+			})
+		})
+
+		Convey("When Each is called with a function that returns an error", func() {
+			stop := errors.New("stop")
+			var seen []string
+			err := client.Items.Iterate(testBoardID).Each(func(item Item) error {
+				seen = append(seen, item.ID)
+				if len(seen) == 3 {
+					return stop
+				}
+				return nil
+			})
+
+			Convey("Then the iteration stops and the error is returned", func() {
+				So(err, ShouldEqual, stop)
+				So(seen, ShouldHaveLength, 3)
+			})
+		})
+
+		Convey("When the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			iter := client.Items.IterateWithContext(ctx, testBoardID)
+			first, err := iter.Next()
+			cancel()
+			_, cancelledErr := iter.Next()
+
+			Convey("Then the iterator stops with the context's error", func() {
+				So(err, ShouldBeNil)
+				So(first.ID, ShouldEqual, "item-0")
+				So(errors.Is(cancelledErr, context.Canceled), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestConnectorsIterate(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "connectors")
+	defer closeAPIServer()
+
+	var cursors []string
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		cursors = append(cursors, r.URL.Query().Get("cursor"))
+		if r.URL.Query().Get("cursor") == "" {
+			json.NewEncoder(w).Encode(ListConnectors{Data: []Connector{{ID: "connector-0"}}, Cursor: "next"})
+		} else {
+			json.NewEncoder(w).Encode(ListConnectors{Data: []Connector{{ID: "connector-1"}}})
+		}
+	})
+
+	Convey("Given a board with 2 connectors, returned 1 per page", t, func() {
+		Convey("When all the connectors are requested from the iterator", func() {
+			connectors, err := client.Connectors.Iterate(testBoardID).All()
+
+			Convey("Then both connectors are returned", func() {
+				So(err, ShouldBeNil)
+				So(connectors, ShouldHaveLength, 2)
+				So(connectors[1].ID, ShouldEqual, "connector-1")
+				So(cursors, ShouldResemble, []string{"", "next"})
+			})
+		})
+	})
+}
+
+func TestTagsIterateTags(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+
+	var offsets []string
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		response := ListItems{Offset: offset, Total: 5}
+		for i := offset; i < offset+2 && i < 5; i++ {
+			response.Data = append(response.Data, Item{ID: fmt.Sprintf("item-%d", i)})
+		}
+		response.Size = len(response.Data)
+		json.NewEncoder(w).Encode(response)
+	})
+
+	Convey("Given 5 items with a tag, returned 2 per page", t, func() {
+		offsets = nil
+
+		Convey("When all the items are requested from the iterator", func() {
+			items, err := client.Tags.IterateTags(testBoardID, testTagID).All()
+
+			Convey("Then every item is returned, following the offsets of the pages", func() {
+				So(err, ShouldBeNil)
+				So(itemIDs(items), ShouldResemble, []string{"item-0", "item-1", "item-2", "item-3", "item-4"})
+				So(offsets, ShouldResemble, []string{"", "2", "4"})
+			})
+		})
+
+		Convey("When the iterator starts from an offset", func() {
+			items, err := client.Tags.IterateTags(testBoardID, testTagID, TagSearchParams{Offset: "2"}).All()
+
+			Convey("Then the items from the offset onwards are returned", func() {
+				So(err, ShouldBeNil)
+				So(itemIDs(items), ShouldResemble, []string{"item-2", "item-3", "item-4"})
+				So(offsets, ShouldResemble, []string{"2", "4"})
+			})
+		})
+	})
+}
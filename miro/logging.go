@@ -0,0 +1,187 @@
+package miro
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// maxLogBodySize the maximum number of bytes of a request or response body that are logged
+	maxLogBodySize = 1024
+	redacted       = "REDACTED"
+)
+
+// LogLevel the verbosity of the client's logging
+type LogLevel int
+
+const (
+	// LogLevelNone disables logging
+	LogLevelNone LogLevel = iota
+	// LogLevelError logs only requests that failed, either with an error or a 4xx/5xx status code
+	LogLevelError
+	// LogLevelInfo logs the method, URL, status & latency of every request
+	LogLevelInfo
+	// LogLevelDebug logs everything LogLevelInfo does, plus the request headers and truncated request & response bodies
+	LogLevelDebug
+)
+
+// LogEntry a record of a single request sent by the client. Tokens are always redacted from the URL, headers & bodies.
+type LogEntry struct {
+	// Level the severity of the entry: LogLevelError for requests that failed, either with an error or a 4xx/5xx status
+	// code, otherwise LogLevelInfo (or LogLevelDebug when the headers & bodies are logged)
+	Level      LogLevel
+	Method     string
+	URL        string
+	StatusCode int
+	Latency    time.Duration
+	Err        error
+	// RequestHeader only set at LogLevelDebug
+	RequestHeader http.Header
+	// RequestBody only set at LogLevelDebug, truncated to 1KB
+	RequestBody string
+	// ResponseBody only set at LogLevelDebug, truncated to 1KB
+	ResponseBody string
+}
+
+func (e LogEntry) String() string {
+	var sb strings.Builder
+	if e.Err != nil {
+		fmt.Fprintf(&sb, "%s %s: error: %v (%s)", e.Method, e.URL, e.Err, e.Latency)
+	} else {
+		fmt.Fprintf(&sb, "%s %s: %d (%s)", e.Method, e.URL, e.StatusCode, e.Latency)
+	}
+	if e.RequestBody != "" {
+		fmt.Fprintf(&sb, "\n  request: %s", e.RequestBody)
+	}
+	if e.ResponseBody != "" {
+		fmt.Fprintf(&sb, "\n  response: %s", e.ResponseBody)
+	}
+	return sb.String()
+}
+
+// Logger records the requests sent by the client
+type Logger interface {
+	Log(entry LogEntry)
+}
+
+// LoggerFunc an adapter to allow the use of an ordinary function as a Logger, e.g.
+//
+//	miro.WithLogger(miro.LoggerFunc(func(e miro.LogEntry) { log.Println(e) }), miro.LogLevelInfo)
+type LoggerFunc func(entry LogEntry)
+
+func (f LoggerFunc) Log(entry LogEntry) {
+	f(entry)
+}
+
+// WithLogger sets the logger of the client and the level of verbosity
+func WithLogger(logger Logger, level LogLevel) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+		c.logLevel = level
+	}
+}
+
+// logRequests a middleware that logs each request sent, at the client's log level
+func (c *Client) logRequests(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		entry := LogEntry{
+			Level:  LogLevelInfo,
+			Method: req.Method,
+			URL:    redactURL(req.URL),
+		}
+		if c.logLevel >= LogLevelDebug {
+			entry.Level = LogLevelDebug
+			entry.RequestHeader = redactHeader(req.Header)
+			entry.RequestBody = peekRequestBody(req)
+		}
+
+		start := time.Now()
+		resp, err := next(req)
+		entry.Latency = time.Since(start)
+		entry.Err = err
+
+		if resp != nil {
+			entry.StatusCode = resp.StatusCode
+			if c.logLevel >= LogLevelDebug {
+				entry.ResponseBody = peekResponseBody(resp)
+			}
+		}
+
+		if err != nil || entry.StatusCode >= http.StatusBadRequest {
+			entry.Level = LogLevelError
+		}
+		if c.logLevel >= LogLevelInfo || entry.Level == LogLevelError {
+			c.logger.Log(entry)
+		}
+		return resp, err
+	}
+}
+
+// redactedParams query parameters that hold secrets
+var redactedParams = []string{"access_token", "refresh_token", "client_secret", "code", "code_verifier"}
+
+// redactedBodyFields matches JSON fields in request & response bodies that hold secrets
+var redactedBodyFields = regexp.MustCompile(`("(?:access_token|refresh_token|client_secret)"\s*:\s*)"[^"]*"`)
+
+func redactURL(u *url.URL) string {
+	redactedURL := *u
+	query := redactedURL.Query()
+	for _, param := range redactedParams {
+		if query.Has(param) {
+			query.Set(param, redacted)
+		}
+	}
+	redactedURL.RawQuery = query.Encode()
+	return redactedURL.String()
+}
+
+func redactHeader(header http.Header) http.Header {
+	redactedHeader := header.Clone()
+	if redactedHeader.Get("Authorization") != "" {
+		redactedHeader.Set("Authorization", "Bearer "+redacted)
+	}
+	return redactedHeader
+}
+
+func redactBody(body []byte) string {
+	if len(body) > maxLogBodySize {
+		body = append(body[:maxLogBodySize:maxLogBodySize], "..."...)
+	}
+	return redactedBodyFields.ReplaceAllString(string(body), `$1"`+redacted+`"`)
+}
+
+// peekRequestBody read the request body without consuming it, only JSON bodies that can be replayed are read
+func peekRequestBody(req *http.Request) string {
+	if req.GetBody == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(body, maxLogBodySize+1))
+	return redactBody(data)
+}
+
+// peekResponseBody read the start of the response body, leaving the body intact for the caller
+func peekResponseBody(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxLogBodySize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+
+	return redactBody(data)
+}
@@ -0,0 +1,151 @@
+package miro
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClientLogging(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "")
+	defer closeAPIServer()
+
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ResponseError{Status: http.StatusBadRequest, Message: "Bad Request"})
+			return
+		}
+		json.NewEncoder(w).Encode(Board{ID: testBoardID, Name: testBoardName})
+	})
+
+	var entries []LogEntry
+	logger := LoggerFunc(func(entry LogEntry) {
+		entries = append(entries, entry)
+	})
+
+	Convey("Given a client with a logger", t, func() {
+		entries = nil
+
+		Convey("When the log level is LogLevelError and a request succeeds", func() {
+			WithLogger(logger, LogLevelError)(client)
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then nothing is logged", func() {
+				So(err, ShouldBeNil)
+				So(entries, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the log level is LogLevelError and a request fails", func() {
+			WithLogger(logger, LogLevelError)(client)
+			_, err := client.Boards.Update(testBoardID, SetBoard{Name: testBoardName})
+
+			Convey("Then the failed request is logged without its bodies", func() {
+				So(err, ShouldNotBeNil)
+				So(len(entries), ShouldEqual, 1)
+				So(entries[0].Method, ShouldEqual, http.MethodPatch)
+				So(entries[0].StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(entries[0].Level, ShouldEqual, LogLevelError)
+				So(entries[0].RequestBody, ShouldBeEmpty)
+				So(entries[0].ResponseBody, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the log level is LogLevelInfo", func() {
+			WithLogger(logger, LogLevelInfo)(client)
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the method, URL, status & latency are logged", func() {
+				So(err, ShouldBeNil)
+				So(len(entries), ShouldEqual, 1)
+				So(entries[0].Method, ShouldEqual, http.MethodGet)
+				So(entries[0].URL, ShouldEqual, client.BaseURL+testResourcePath)
+				So(entries[0].StatusCode, ShouldEqual, http.StatusOK)
+				So(entries[0].Level, ShouldEqual, LogLevelInfo)
+				So(entries[0].Latency, ShouldBeGreaterThan, 0)
+				So(entries[0].RequestHeader, ShouldBeNil)
+			})
+		})
+
+		Convey("When the log level is LogLevelDebug", func() {
+			WithLogger(logger, LogLevelDebug)(client)
+			results, err := client.Boards.Update(testBoardID, SetBoard{Name: testBoardName})
+			board, getErr := client.Boards.Get(testBoardID)
+
+			Convey("Then the headers & bodies are logged, with the token redacted", func() {
+				So(err, ShouldNotBeNil)
+				So(results, ShouldNotBeNil)
+				So(len(entries), ShouldEqual, 2)
+				So(entries[0].Level, ShouldEqual, LogLevelError)
+				So(entries[1].Level, ShouldEqual, LogLevelDebug)
+				So(entries[0].RequestBody, ShouldContainSubstring, testBoardName)
+				So(entries[0].ResponseBody, ShouldContainSubstring, "Bad Request")
+				So(entries[0].RequestHeader.Get("Authorization"), ShouldEqual, "Bearer REDACTED")
+				So(fmt.Sprint(entries[0]), ShouldNotContainSubstring, testToken)
+
+				Convey("And the response body can still be read by the client", func() {
+					So(getErr, ShouldBeNil)
+					So(board.Name, ShouldEqual, testBoardName)
+				})
+			})
+		})
+	})
+}
+
+func TestLoggingRedactsAccessToken(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v1", endpointOAUTH, "revoke", "")
+	defer closeAPIServer()
+
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var entries []LogEntry
+	WithLogger(LoggerFunc(func(entry LogEntry) {
+		entries = append(entries, entry)
+	}), LogLevelDebug)(client)
+
+	Convey("Given a client with a logger", t, func() {
+		Convey("When an access token is revoked", func() {
+			err := client.AccessToken.Revoke("secret-access-token")
+
+			Convey("Then the access_token query parameter is redacted", func() {
+				So(err, ShouldBeNil)
+				So(len(entries), ShouldEqual, 1)
+				So(entries[0].URL, ShouldEndWith, "?access_token=REDACTED")
+				So(entries[0].String(), ShouldNotContainSubstring, "secret-access-token")
+			})
+		})
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	Convey("Given a body containing tokens", t, func() {
+		body := []byte(`{"access_token": "abc", "refresh_token":"def", "user_id": "1"}`)
+
+		Convey("When the body is redacted", func() {
+			result := redactBody(body)
+
+			Convey("Then the tokens are replaced", func() {
+				So(result, ShouldEqual, `{"access_token": "REDACTED", "refresh_token":"REDACTED", "user_id": "1"}`)
+			})
+		})
+	})
+
+	Convey("Given a large body", t, func() {
+		body := []byte(strings.Repeat("a", maxLogBodySize*2))
+
+		Convey("When the body is redacted", func() {
+			result := redactBody(body)
+
+			Convey("Then the body is truncated", func() {
+				So(len(result), ShouldEqual, maxLogBodySize+3)
+				So(result, ShouldEndWith, "...")
+			})
+		})
+	})
+}
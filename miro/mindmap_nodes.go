@@ -0,0 +1,155 @@
+package miro
+
+import "context"
+
+// MindmapNodesService the mind map nodes of a board. Mind maps are only available in the experimental version of the
+// MIRO API, so the endpoints & types may change.
+type MindmapNodesService struct {
+	client      *Client
+	apiVersion  string
+	resource    string
+	subResource string
+}
+
+// Create a mind map node on a board. A node without a parent is the root of a new mind map.
+// Required scope: boards:write | Rate limiting: Level 2
+func (m *MindmapNodesService) Create(boardID string, payload MindmapNodeSet) (*MindmapNode, error) {
+	return m.CreateWithContext(m.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (m *MindmapNodesService) CreateWithContext(ctx context.Context, boardID string, payload MindmapNodeSet) (*MindmapNode, error) {
+	response := &MindmapNode{}
+
+	if url, err := constructURL(m.client.BaseURL, m.apiVersion, m.resource, boardID, m.subResource); err != nil {
+		return response, err
+	} else {
+		err = m.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
+		return response, err
+	}
+}
+
+// CreateMindmap creates a whole mind map from the outline, the root node at the position & its children (and their
+// children) attached to it. The nodes are returned in the order they were created, i.e. each node before its children.
+// If a node can't be created the nodes created so far are returned along with the error, so that they can be deleted.
+// Required scope: boards:write | Rate limiting: Level 2 (per node)
+func (m *MindmapNodesService) CreateMindmap(boardID string, outline MindmapOutline, position PositionSet) ([]MindmapNode, error) {
+	return m.CreateMindmapWithContext(m.client.ctx, boardID, outline, position)
+}
+
+// CreateMindmapWithContext CreateMindmap using the given context, which can be used to cancel the creation of the nodes
+// or set a deadline.
+func (m *MindmapNodesService) CreateMindmapWithContext(ctx context.Context, boardID string, outline MindmapOutline, position PositionSet) ([]MindmapNode, error) {
+	var nodes []MindmapNode
+
+	var create func(outline MindmapOutline, parent *ParentSet) error
+	create = func(outline MindmapOutline, parent *ParentSet) error {
+		payload := MindmapNodeSet{
+			Data:   MindmapNodeDataSet{NodeView: MindmapNodeViewSet{Data: MindmapNodeContentSet{Content: outline.Content}}},
+			Parent: parent,
+		}
+		if parent == nil {
+			payload.Position = &position
+		}
+
+		node, err := m.CreateWithContext(ctx, boardID, payload)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, *node)
+
+		for _, child := range outline.Children {
+			if err := create(child, &ParentSet{ID: node.ID}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := create(outline, nil)
+	return nodes, err
+}
+
+// Get a specific mind map node on a board.
+// Required scope: boards:read | Rate limiting: Level 1
+func (m *MindmapNodesService) Get(boardID, itemID string) (*MindmapNode, error) {
+	return m.GetWithContext(m.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (m *MindmapNodesService) GetWithContext(ctx context.Context, boardID, itemID string) (*MindmapNode, error) {
+	response := &MindmapNode{}
+
+	if url, err := constructURL(m.client.BaseURL, m.apiVersion, m.resource, boardID, m.subResource, itemID); err != nil {
+		return response, err
+	} else {
+		err = m.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
+		return response, err
+	}
+}
+
+// GetAll mind map nodes on a board. This method returns results using a cursor-based approach, see ItemsService.GetAll.
+// Required scope: boards:read | Rate limiting: Level 1
+// Search query params: MindmapNodeSearchParams{}
+func (m *MindmapNodesService) GetAll(boardID string, queryParams ...MindmapNodeSearchParams) (*ListMindmapNodes, error) {
+	return m.GetAllWithContext(m.client.ctx, boardID, queryParams...)
+}
+
+// GetAllWithContext GetAll using the given context, which can be used to cancel the request or set a deadline.
+func (m *MindmapNodesService) GetAllWithContext(ctx context.Context, boardID string, queryParams ...MindmapNodeSearchParams) (*ListMindmapNodes, error) {
+	response := &ListMindmapNodes{}
+
+	if url, err := constructURL(m.client.BaseURL, m.apiVersion, m.resource, boardID, m.subResource); err != nil {
+		return response, err
+	} else {
+		var searchParams []Parameter
+		if len(queryParams) > 0 {
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
+		}
+		err = m.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response, searchParams...)
+
+		return response, err
+	}
+}
+
+// Iterate returns an iterator over all the mind map nodes on a board, fetching the pages of results as they are needed.
+// The Cursor of the search params is used as the starting point.
+// Required scope: boards:read | Rate limiting: Level 1 (per page)
+func (m *MindmapNodesService) Iterate(boardID string, queryParams ...MindmapNodeSearchParams) *Iterator[MindmapNode] {
+	return m.IterateWithContext(m.client.ctx, boardID, queryParams...)
+}
+
+// IterateWithContext Iterate using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (m *MindmapNodesService) IterateWithContext(ctx context.Context, boardID string, queryParams ...MindmapNodeSearchParams) *Iterator[MindmapNode] {
+	var params MindmapNodeSearchParams
+	if len(queryParams) > 0 {
+		params = queryParams[0]
+	}
+
+	iter := newIterator(ctx, func(ctx context.Context, cursor string) ([]MindmapNode, string, error) {
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+		response, err := m.GetAllWithContext(ctx, boardID, params)
+		return response.Data, response.Cursor, err
+	})
+	iter.next = params.Cursor
+	return iter
+}
+
+// Delete a mind map node, along with its child nodes.
+// Required scope: boards:write | Rate limiting: Level 3
+func (m *MindmapNodesService) Delete(boardID, itemID string) error {
+	return m.DeleteWithContext(m.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (m *MindmapNodesService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
+	if url, err := constructURL(m.client.BaseURL, m.apiVersion, m.resource, boardID, m.subResource, itemID); err != nil {
+		return err
+	} else {
+		return m.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
+	}
+}
@@ -0,0 +1,182 @@
+package miro
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestCreateMindmapNode(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2-experimental", endpointBoards, testBoardID, "mindmap_nodes")
+	defer closeAPIServer()
+
+	expectedResults := &MindmapNode{}
+	responseData := constructResponseAndResults("mindmap_node_get.json", expectedResults)
+
+	Convey("Given a board ID and the content of a node", t, func() {
+		Convey("When the Create method is called", func() {
+			var receivedRequest *http.Request
+			var body map[string]interface{}
+			mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&body)
+				w.WriteHeader(http.StatusCreated)
+				w.Write(responseData)
+				receivedRequest = r
+			})
+
+			results, err := client.MindmapNodes.Create(testBoardID, MindmapNodeSet{
+				Data: MindmapNodeDataSet{NodeView: MindmapNodeViewSet{Data: MindmapNodeContentSet{Content: "Q3 planning"}}},
+			})
+
+			Convey("Then the node is created", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+				So(results.Data.IsRoot, ShouldBeTrue)
+				So(results.Data.NodeView.Data.Content, ShouldEqual, "Q3 planning")
+
+				Convey("And the request is sent to the experimental API, without a parent", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodPost)
+					So(receivedRequest.Header.Get("Authorization"), ShouldEqual, fmt.Sprintf("Bearer %s", testToken))
+					So(receivedRequest.URL.Path, ShouldEqual, "/v2-experimental/boards/"+testBoardID+"/mindmap_nodes")
+					So(body, ShouldNotContainKey, "parent")
+				})
+			})
+		})
+	})
+}
+
+func TestGetMindmapNodes(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2-experimental", endpointBoards, testBoardID, "mindmap_nodes")
+	defer closeAPIServer()
+
+	expectedNode := &MindmapNode{}
+	nodeData := constructResponseAndResults("mindmap_node_get.json", expectedNode)
+	expectedList := &ListMindmapNodes{}
+	listData := constructResponseAndResults("mindmap_nodes_get_all.json", expectedList)
+
+	var receivedRequest *http.Request
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(listData)
+		receivedRequest = r
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, testItemID), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.Write(nodeData)
+		}
+		receivedRequest = r
+	})
+
+	Convey("Given a board ID and a node ID", t, func() {
+		Convey("When the Get method is called", func() {
+			results, err := client.MindmapNodes.Get(testBoardID, testItemID)
+
+			Convey("Then the node is returned", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedNode)
+				So(receivedRequest.URL.Path, ShouldEqual, fmt.Sprintf("%s/%s", testResourcePath, testItemID))
+			})
+		})
+
+		Convey("When the Delete method is called", func() {
+			err := client.MindmapNodes.Delete(testBoardID, testItemID)
+
+			Convey("Then the node is deleted (no error is returned)", func() {
+				So(err, ShouldBeNil)
+				So(receivedRequest.Method, ShouldEqual, http.MethodDelete)
+			})
+		})
+	})
+
+	Convey("Given a board ID and MindmapNodeSearchParams", t, func() {
+		Convey("When the GetAll method is called", func() {
+			results, err := client.MindmapNodes.GetAll(testBoardID, MindmapNodeSearchParams{Limit: "10"})
+
+			Convey("Then the nodes are returned, with their parents", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedList)
+				So(results.Data[1].Parent.ID, ShouldEqual, results.Data[0].ID)
+				So(receivedRequest.URL.Query().Get("limit"), ShouldEqual, "10")
+			})
+		})
+
+		Convey("When the nodes are iterated over", func() {
+			nodes, err := client.MindmapNodes.Iterate(testBoardID).All()
+
+			Convey("Then every node is returned", func() {
+				So(err, ShouldBeNil)
+				So(nodes, ShouldResemble, expectedList.Data)
+			})
+		})
+	})
+}
+
+func TestCreateMindmap(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2-experimental", endpointBoards, testBoardID, "mindmap_nodes")
+	defer closeAPIServer()
+
+	var created []MindmapNodeSet
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		var payload MindmapNodeSet
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload.Data.NodeView.Data.Content == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		created = append(created, payload)
+
+		node := MindmapNode{ID: strconv.Itoa(len(created)), Data: MindmapNodeData{IsRoot: payload.Parent == nil}}
+		node.Data.NodeView.Data.Content = payload.Data.NodeView.Data.Content
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(node)
+	})
+
+	Convey("Given an outline of a mind map", t, func() {
+		created = nil
+		outline := MindmapOutline{Content: "Q3", Children: []MindmapOutline{
+			{Content: "Hiring", Children: []MindmapOutline{{Content: "Backend"}, {Content: "Design"}}},
+			{Content: "Launch"},
+		}}
+
+		Convey("When the mind map is created", func() {
+			nodes, err := client.MindmapNodes.CreateMindmap(testBoardID, outline, PositionSet{X: 100, Y: 200})
+
+			Convey("Then each node is created after its parent, attached to it", func() {
+				So(err, ShouldBeNil)
+				So(nodes, ShouldHaveLength, 5)
+
+				contents := make([]string, len(created))
+				parents := make([]string, len(created))
+				for i, payload := range created {
+					contents[i] = payload.Data.NodeView.Data.Content
+					if payload.Parent != nil {
+						parents[i] = payload.Parent.ID
+					}
+				}
+				So(contents, ShouldResemble, []string{"Q3", "Hiring", "Backend", "Design", "Launch"})
+				So(parents, ShouldResemble, []string{"", "1", "2", "2", "1"})
+
+				Convey("And only the root is positioned", func() {
+					So(created[0].Position, ShouldResemble, &PositionSet{X: 100, Y: 200})
+					So(created[1].Position, ShouldBeNil)
+					So(nodes[0].Data.IsRoot, ShouldBeTrue)
+				})
+			})
+		})
+
+		Convey("When a node can't be created", func() {
+			outline.Children[1].Content = "fail"
+			nodes, err := client.MindmapNodes.CreateMindmap(testBoardID, outline, PositionSet{})
+
+			Convey("Then the nodes created so far are returned with the error", func() {
+				So(err, ShouldNotBeNil)
+				So(nodes, ShouldHaveLength, 4)
+			})
+		})
+	})
+}
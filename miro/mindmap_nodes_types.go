@@ -0,0 +1,146 @@
+package miro
+
+import "time"
+
+// MindmapNodeContent the content of a mind map node, currently only text is supported
+type MindmapNodeContent struct {
+	// Type Type of the content of the node, text.
+	Type string `json:"type,omitempty"`
+	// Content The text of the node.
+	Content string `json:"content"`
+	Extra   Extra  `json:"-"`
+}
+
+func (m *MindmapNodeContent) UnmarshalJSON(data []byte) error {
+	type mindmapNodeContent MindmapNodeContent
+	return unmarshalWithExtra(data, (*mindmapNodeContent)(m), &m.Extra)
+}
+
+func (m MindmapNodeContent) MarshalJSON() ([]byte, error) {
+	type mindmapNodeContent MindmapNodeContent
+	return marshalWithExtra(mindmapNodeContent(m), m.Extra)
+}
+
+type MindmapNodeView struct {
+	// Type Type of the view of the node, text.
+	Type  string             `json:"type,omitempty"`
+	Data  MindmapNodeContent `json:"data"`
+	Extra Extra              `json:"-"`
+}
+
+func (m *MindmapNodeView) UnmarshalJSON(data []byte) error {
+	type mindmapNodeView MindmapNodeView
+	return unmarshalWithExtra(data, (*mindmapNodeView)(m), &m.Extra)
+}
+
+func (m MindmapNodeView) MarshalJSON() ([]byte, error) {
+	type mindmapNodeView MindmapNodeView
+	return marshalWithExtra(mindmapNodeView(m), m.Extra)
+}
+
+type MindmapNodeData struct {
+	// IsRoot Whether the node is the root of its mind map.
+	IsRoot   bool            `json:"isRoot"`
+	NodeView MindmapNodeView `json:"nodeView"`
+	Extra    Extra           `json:"-"`
+}
+
+func (m *MindmapNodeData) UnmarshalJSON(data []byte) error {
+	type mindmapNodeData MindmapNodeData
+	return unmarshalWithExtra(data, (*mindmapNodeData)(m), &m.Extra)
+}
+
+func (m MindmapNodeData) MarshalJSON() ([]byte, error) {
+	type mindmapNodeData MindmapNodeData
+	return marshalWithExtra(mindmapNodeData(m), m.Extra)
+}
+
+type MindmapNode struct {
+	ID         string          `json:"id"`
+	Data       MindmapNodeData `json:"data"`
+	Position   Position        `json:"position"`
+	Geometry   Geometry        `json:"geometry"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	CreatedBy  BasicEntityInfo `json:"createdBy"`
+	ModifiedAt time.Time       `json:"modifiedAt"`
+	ModifiedBy BasicEntityInfo `json:"modifiedBy"`
+	// Parent The parent node, empty for the root of a mind map.
+	Parent Parent `json:"parent"`
+	Links  Links  `json:"links"`
+	Type   string `json:"type"`
+	Extra  Extra  `json:"-"`
+}
+
+func (m *MindmapNode) UnmarshalJSON(data []byte) error {
+	type mindmapNode MindmapNode
+	return unmarshalWithExtra(data, (*mindmapNode)(m), &m.Extra)
+}
+
+func (m MindmapNode) MarshalJSON() ([]byte, error) {
+	type mindmapNode MindmapNode
+	return marshalWithExtra(mindmapNode(m), m.Extra)
+}
+
+type MindmapNodeSet struct {
+	Data MindmapNodeDataSet `json:"data"`
+	// Position Contains location information about the node. Only the position of a root node is used, the MIRO API
+	// arranges the other nodes around their parent.
+	Position *PositionSet `json:"position,omitempty"`
+	// Geometry Contains geometrical information about the node, only the width can be set.
+	Geometry *GeometrySet `json:"geometry,omitempty"`
+	// Parent The parent node, nil to create the root node of a new mind map.
+	Parent *ParentSet `json:"parent,omitempty"`
+}
+
+type MindmapNodeDataSet struct {
+	NodeView MindmapNodeViewSet `json:"nodeView"`
+}
+
+type MindmapNodeViewSet struct {
+	Data MindmapNodeContentSet `json:"data"`
+}
+
+type MindmapNodeContentSet struct {
+	// Type Type of the content of the node. Default: text.
+	Type string `json:"type,omitempty"`
+	// Content The text of the node. (required)
+	Content string `json:"content"`
+}
+
+type ListMindmapNodes struct {
+	Data   []MindmapNode   `json:"data"`
+	Total  int             `json:"total"`
+	Size   int             `json:"size"`
+	Cursor string          `json:"cursor,omitempty"`
+	Limit  int             `json:"limit"`
+	Links  PaginationLinks `json:"links"`
+	Type   string          `json:"type"`
+	Extra  Extra           `json:"-"`
+}
+
+func (l *ListMindmapNodes) UnmarshalJSON(data []byte) error {
+	type listMindmapNodes ListMindmapNodes
+	return unmarshalWithExtra(data, (*listMindmapNodes)(l), &l.Extra)
+}
+
+func (l ListMindmapNodes) MarshalJSON() ([]byte, error) {
+	type listMindmapNodes ListMindmapNodes
+	return marshalWithExtra(listMindmapNodes(l), l.Extra)
+}
+
+type MindmapNodeSearchParams struct {
+	// Limit The maximum number of results to return per call. If the number of nodes in the response is greater than the
+	// limit specified, the response returns the cursor parameter with a value.
+	// Default: 10. Minimum 10. Maximum 50.
+	Limit string `query:"limit,omitempty"`
+	// Cursor A cursor-paginated method returns a portion of the total set of results based on the limit specified and a
+	// cursor that points to the next portion of the results. To retrieve the next portion of the collection, set the
+	// cursor parameter equal to the cursor value you received in the response of the previous request.
+	Cursor string `query:"cursor,omitempty"`
+}
+
+// MindmapOutline an in-memory tree of text, created as a whole mind map by MindmapNodesService.CreateMindmap
+type MindmapOutline struct {
+	Content  string
+	Children []MindmapOutline
+}
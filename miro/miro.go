@@ -19,7 +19,22 @@ type Client struct {
 	BaseURL string
 	token   string
 	// HTTPClient a fine-tuned HTTP client, but you can inject your own if, for example, you wanted to lower the timeouts
-	HTTPClient    *http.Client
+	HTTPClient *http.Client
+	// RetryPolicy controls the retrying of requests that fail with a 429 or 5xx status code, nil disables retries
+	RetryPolicy *RetryPolicy
+	// RateLimiter a client-side credit budget, shared by all requests made with this client, nil disables it
+	RateLimiter      *CreditLimiter
+	userAgent        string
+	headers          http.Header
+	middleware       []Middleware
+	responseCallback ResponseCallback
+	logger           Logger
+	logLevel         LogLevel
+	tokenSource      TokenSource
+	validate         bool
+	apiVersions      map[APIResource]APIVersion
+	// optionErr the first error of the options the client was created with, returned by every request
+	optionErr     error
 	ctx           context.Context
 	AccessToken   *AccessTokenService
 	Boards        *BoardsService
@@ -36,32 +51,15 @@ type Client struct {
 	StickyNotes   *StickyNotesService
 	TextItems     *TextItemsService
 	Tags          *TagsService
+	Groups        *GroupsService
+	MindmapNodes  *MindmapNodesService
+	Webhooks      *WebhooksService
 	OEmbed        *OEmbedServices
 }
 
-type Field struct {
-	Field   string `json:"field"`
-	Message string `json:"message"`
-}
-
-type Context struct {
-	Fields []Field `json:"fields"`
-}
-
-type ResponseError struct {
-	// Status code of the error
-	Status int `json:"status"`
-	// Code of the error
-	Code string `json:"code"`
-	// Context details of the error
-	Context Context `json:"context"`
-	// Description of the error
-	Message string `json:"message"`
-	// Type of the error
-	Type string `json:"type"`
-}
-
-func NewClient(token string) *Client {
+// NewClient creates a client for the MIRO API, authenticating with the given token. Options can be passed to customise
+// the client, e.g. NewClient(token, WithUserAgent("my-app"), WithMiddleware(logRequests))
+func NewClient(token string, opts ...ClientOption) *Client {
 	var baseURL string
 	if mockServer := os.Getenv("MIRO_MOCK_SERVER"); mockServer != "" {
 		baseURL = mockServer
@@ -75,243 +73,214 @@ func NewClient(token string) *Client {
 		HTTPClient: httpClient(),
 		ctx:        context.Background(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
 	buildAPIMap(c)
 
 	return c
 }
 
 func buildAPIMap(c *Client) {
+	version := c.apiVersion
 	c.AccessToken = &AccessTokenService{client: c, apiVersion: "v1"}
-	c.Boards = &BoardsService{client: c, apiVersion: "v2", resource: "boards"}
-	c.BoardMembers = &BoardMembersService{client: c, apiVersion: "v2", resource: "boards", subResource: "members"}
-	c.Items = &ItemsService{client: c, apiVersion: "v2", resource: "boards", subResource: "items"}
-	c.AppCardItems = &AppCardItemsService{client: c, apiVersion: "v2", resource: "boards", subResource: "app_cards"}
-	c.CardItems = &CardItemsService{client: c, apiVersion: "v2", resource: "boards", subResource: "cards"}
-	c.ShapeItems = &ShapeItemsService{client: c, apiVersion: "v2", resource: "boards", subResource: "shapes"}
-	c.Connectors = &ConnectorsService{client: c, apiVersion: "v2", resource: "boards", subResource: "connectors"}
-	c.DocumentItems = &DocumentsService{client: c, apiVersion: "v2", resource: "boards", subResource: "documents"}
-	c.EmbedItems = &EmbedItemsService{client: c, apiVersion: "v2", resource: "boards", subResource: "embeds"}
-	c.Frames = &FramesService{client: c, apiVersion: "v2", resource: "boards", subResource: "frames"}
-	c.Images = &ImagesService{client: c, apiVersion: "v2", resource: "boards", subResource: "images"}
-	c.StickyNotes = &StickyNotesService{client: c, apiVersion: "v2", resource: "boards", subResource: "sticky_notes"}
-	c.TextItems = &TextItemsService{client: c, apiVersion: "v2", resource: "boards", subResource: "texts"}
-	c.Tags = &TagsService{client: c, apiVersion: "v2", resource: "boards", subResource: "tags"}
-	c.OEmbed = &OEmbedServices{client: c, apiVersion: "v1", resource: "oembed"}
+	c.Boards = &BoardsService{client: c, apiVersion: version(APIResourceBoards, APIVersionV2), resource: "boards"}
+	c.BoardMembers = &BoardMembersService{client: c, apiVersion: version(APIResourceBoardMembers, APIVersionV2), resource: "boards", subResource: "members"}
+	c.Items = &ItemsService{client: c, apiVersion: version(APIResourceItems, APIVersionV2), resource: "boards", subResource: "items"}
+	c.AppCardItems = &AppCardItemsService{client: c, apiVersion: version(APIResourceAppCards, APIVersionV2), resource: "boards", subResource: "app_cards"}
+	c.CardItems = &CardItemsService{client: c, apiVersion: version(APIResourceCards, APIVersionV2), resource: "boards", subResource: "cards"}
+	c.ShapeItems = &ShapeItemsService{client: c, apiVersion: version(APIResourceShapes, APIVersionV2), resource: "boards", subResource: "shapes"}
+	c.Connectors = &ConnectorsService{client: c, apiVersion: version(APIResourceConnectors, APIVersionV2), resource: "boards", subResource: "connectors"}
+	c.DocumentItems = &DocumentsService{client: c, apiVersion: version(APIResourceDocuments, APIVersionV2), resource: "boards", subResource: "documents"}
+	c.EmbedItems = &EmbedItemsService{client: c, apiVersion: version(APIResourceEmbeds, APIVersionV2), resource: "boards", subResource: "embeds"}
+	c.Frames = &FramesService{client: c, apiVersion: version(APIResourceFrames, APIVersionV2), resource: "boards", subResource: "frames"}
+	c.Images = &ImagesService{client: c, apiVersion: version(APIResourceImages, APIVersionV2), resource: "boards", subResource: "images"}
+	c.StickyNotes = &StickyNotesService{client: c, apiVersion: version(APIResourceStickyNotes, APIVersionV2), resource: "boards", subResource: "sticky_notes"}
+	c.TextItems = &TextItemsService{client: c, apiVersion: version(APIResourceTexts, APIVersionV2), resource: "boards", subResource: "texts"}
+	c.Tags = &TagsService{client: c, apiVersion: version(APIResourceTags, APIVersionV2), resource: "boards", subResource: "tags"}
+	c.Groups = &GroupsService{client: c, apiVersion: version(APIResourceGroups, APIVersionV2), resource: "boards", subResource: "groups"}
+	c.MindmapNodes = &MindmapNodesService{client: c, apiVersion: version(APIResourceMindmapNodes, APIVersionV2Experimental), resource: "boards", subResource: "mindmap_nodes"}
+	c.Webhooks = &WebhooksService{client: c, apiVersion: version(APIResourceWebhooks, APIVersionV2Experimental), resource: "webhooks"}
+	c.OEmbed = &OEmbedServices{client: c, apiVersion: version(APIResourceOEmbed, APIVersionV1), resource: "oembed"}
 }
 
-// Get Native GET function
-func (c *Client) Get(ctx context.Context, url string, response interface{}, queryParams ...Parameter) error {
-	if len(queryParams) > 0 {
-		url = fmt.Sprintf("%s%s", url, encodeQueryParams(queryParams))
-	}
+// Err returns the error of the options the client was created with, if any, e.g. an unknown resource passed to
+// WithAPIVersion. The same error is returned by every call made with the client.
+func (c *Client) Err() error {
+	return c.optionErr
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return err
+// apiVersion the version of the MIRO API used by the service with the given resource, see WithAPIVersion
+func (c *Client) apiVersion(resource APIResource, defaultVersion APIVersion) string {
+	if version, ok := c.apiVersions[resource]; ok {
+		return string(version)
 	}
+	return string(defaultVersion)
+}
 
-	c.addHeaders(req)
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusOK {
-			return constructErrorMsg(resp)
-		}
-		return json.NewDecoder(resp.Body).Decode(&response)
-	}
+// request a single call to the MIRO API, sent by execute
+type request struct {
+	method      string
+	url         string
+	queryParams []Parameter
+	// payload the JSON request body, if any
+	payload interface{}
+	// parts the multipart form request body, if any (takes precedence over the payload)
+	parts MultiParts
+	// response decoded from the JSON response body, if any
+	response interface{}
+	// statuses the status codes accepted as success, defaults to the success status codes of the method
+	statuses []int
 }
 
-// Post Native POST function
-func (c *Client) Post(ctx context.Context, url string, payload, response interface{}) error {
-	bufBody, err := payloadToBuffer(payload)
-	if err != nil {
-		return err
-	}
+// successStatuses the status codes accepted as success for each method, unless a request sets its own
+var successStatuses = map[string][]int{
+	http.MethodGet:    {http.StatusOK},
+	http.MethodPost:   {http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent},
+	http.MethodPut:    {http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent},
+	http.MethodPatch:  {http.StatusOK, http.StatusAccepted, http.StatusNoContent},
+	http.MethodDelete: {http.StatusOK, http.StatusAccepted, http.StatusNoContent},
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bufBody)
-	if err != nil {
-		return err
-	}
+// Get Native GET function
+func (c *Client) Get(ctx context.Context, url string, response interface{}, queryParams ...Parameter) error {
+	return c.execute(ctx, request{method: http.MethodGet, url: url, queryParams: queryParams, response: response})
+}
 
-	c.addHeaders(req)
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusCreated {
-			return constructErrorMsg(resp)
-		}
-		return json.NewDecoder(resp.Body).Decode(&response)
-	}
+// Post Native POST function
+func (c *Client) Post(ctx context.Context, url string, payload, response interface{}) error {
+	return c.execute(ctx, request{method: http.MethodPost, url: url, payload: payload, response: response})
 }
 
+// PostMultipart Native POST function for multipart forms, the form is streamed rather than held in memory
 func (c *Client) PostMultipart(ctx context.Context, url string, parts MultiParts, response interface{}) error {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
-	for key, value := range parts {
-		if part, err := createFormPart(key, value.FileName, value.ContentType, writer); err != nil {
-			return err
-		} else {
-			if _, err = io.Copy(part, value.Reader); err != nil {
-				return err
-			}
-		}
-	}
+	return c.execute(ctx, request{method: http.MethodPost, url: url, parts: parts, response: response})
+}
 
-	// finalize the multipart request
-	if err := writer.Close(); err != nil {
-		return err
-	}
+// postNoContent Native POST function, expects http status code 204 (no content) or 200 and can accept queryParams
+func (c *Client) postNoContent(ctx context.Context, url string, queryParams ...Parameter) error {
+	return c.execute(ctx, request{method: http.MethodPost, url: url, queryParams: queryParams,
+		statuses: []int{http.StatusOK, http.StatusNoContent}})
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
-	if err != nil {
-		return err
-	}
+// Put Native PUT function
+func (c *Client) Put(ctx context.Context, url string, payload, response interface{}, queryParams ...Parameter) error {
+	return c.execute(ctx, request{method: http.MethodPut, url: url, queryParams: queryParams, payload: payload, response: response})
+}
 
-	// set the content type
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+// Patch Native PATCH function
+func (c *Client) Patch(ctx context.Context, url string, payload, response interface{}) error {
+	return c.execute(ctx, request{method: http.MethodPatch, url: url, payload: payload, response: response})
+}
 
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusCreated {
-			return constructErrorMsg(resp)
-		}
-		return json.NewDecoder(resp.Body).Decode(&response)
-	}
+// PatchMultipart Native PATCH function for multipart forms, the form is streamed rather than held in memory
+func (c *Client) PatchMultipart(ctx context.Context, url string, parts MultiParts, response interface{}) error {
+	return c.execute(ctx, request{method: http.MethodPatch, url: url, parts: parts, response: response})
 }
 
-// postNoContent Native POST function, expects http status code 204 (no content) and can accept queryParams
-func (c *Client) postNoContent(ctx context.Context, url string, queryParams ...Parameter) error {
-	if len(queryParams) > 0 {
-		url = fmt.Sprintf("%s%s", url, encodeQueryParams(queryParams))
-	}
+// Delete Native DELETE function
+func (c *Client) Delete(ctx context.Context, url string, queryParams ...Parameter) error {
+	return c.execute(ctx, request{method: http.MethodDelete, url: url, queryParams: queryParams})
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
-	if err != nil {
-		return err
+// execute build & send the request (unless one of the client's options failed), returning a ResponseError if the
+// status code isn't one of the request's accepted statuses, otherwise decoding the response body (if any) into the request's response. The response body is always
+// drained & closed so that the connection can be reused.
+func (c *Client) execute(ctx context.Context, r request) error {
+	if c.optionErr != nil {
+		return c.optionErr
 	}
-
-	c.addHeaders(req)
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusNoContent {
-			return constructErrorMsg(resp)
+	if c.validate {
+		version, _ := ctx.Value(apiVersionKey{}).(APIVersion)
+		if err := validatePayload(r.payload, version); err != nil {
+			return err
 		}
-		return nil
-	}
-}
-
-// Put Native PUT function
-func (c *Client) Put(ctx context.Context, url string, payload, response interface{}, queryParams ...Parameter) error {
-	if len(queryParams) > 0 {
-		url = fmt.Sprintf("%s%s", url, encodeQueryParams(queryParams))
 	}
 
-	bufBody, err := payloadToBuffer(payload)
+	req, err := c.newRequest(ctx, r)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bufBody)
+	resp, err := c.do(req)
 	if err != nil {
 		return err
 	}
+	defer drainAndClose(resp.Body)
 
-	c.addHeaders(req)
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusCreated {
-			return constructErrorMsg(resp)
-		}
-		return json.NewDecoder(resp.Body).Decode(&response)
+	statuses := r.statuses
+	if statuses == nil {
+		statuses = successStatuses[r.method]
 	}
-}
-
-// Patch Native PATCH function
-func (c *Client) Patch(ctx context.Context, url string, payload, response interface{}) error {
-	bufBody, err := payloadToBuffer(payload)
-	if err != nil {
-		return err
+	if !containsStatus(statuses, resp.StatusCode) {
+		return newResponseError(resp)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bufBody)
-	if err != nil {
-		return err
-	}
+	return decodeResponse(resp, r.response)
+}
 
-	c.addHeaders(req)
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusOK {
-			return constructErrorMsg(resp)
-		}
-		return json.NewDecoder(resp.Body).Decode(&response)
+// newRequest build the HTTP request, with its query parameters, body & headers
+func (c *Client) newRequest(ctx context.Context, r request) (*http.Request, error) {
+	url := r.url
+	if len(r.queryParams) > 0 {
+		url = fmt.Sprintf("%s%s", url, encodeQueryParams(r.queryParams))
 	}
-}
 
-func (c *Client) PatchMultipart(ctx context.Context, url string, parts MultiParts, response interface{}) error {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
+	if r.parts != nil {
+		body := newMultipartBody(r.parts)
+		reader := body.open()
 
-	for key, value := range parts {
-		if part, err := createFormPart(key, value.FileName, value.ContentType, writer); err != nil {
-			return err
-		} else {
-			if _, err = io.Copy(part, value.Reader); err != nil {
-				return err
-			}
+		req, err := http.NewRequestWithContext(ctx, r.method, url, reader)
+		if err != nil {
+			reader.Close()
+			return nil, err
+		}
+		if body.replayable() {
+			req.GetBody = body.getBody
 		}
+
+		// set the content type
+		req.Header.Add("Content-Type", body.contentType())
+		c.addDefaultHeaders(req)
+		return req, nil
 	}
 
-	// finalize the multipart request
-	if err := writer.Close(); err != nil {
-		return err
+	var body io.Reader
+	if r.payload != nil {
+		bufBody, err := payloadToBuffer(r.payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bufBody
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, body)
+	req, err := http.NewRequestWithContext(ctx, r.method, url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// set the content type
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
-
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusOK {
-			return constructErrorMsg(resp)
-		}
-		return json.NewDecoder(resp.Body).Decode(&response)
-	}
+	c.addHeaders(req)
+	return req, nil
 }
 
-// Delete Native DELETE function
-func (c *Client) Delete(ctx context.Context, url string, queryParams ...Parameter) error {
-	if len(queryParams) > 0 {
-		url = fmt.Sprintf("%s%s", url, encodeQueryParams(queryParams))
+// decodeResponse decode the JSON response body into response, leaving response untouched if the body is empty
+func decodeResponse(resp *http.Response, response interface{}) error {
+	if response == nil || resp.StatusCode == http.StatusNoContent || resp.Body == nil || resp.ContentLength == 0 {
+		return nil
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
-	if err != nil {
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil && err != io.EOF {
 		return err
 	}
+	return nil
+}
 
-	c.addHeaders(req)
-	if resp, err := c.HTTPClient.Do(req); err != nil {
-		return err
-	} else {
-		if resp.StatusCode != http.StatusNoContent {
-			return constructErrorMsg(resp)
+func containsStatus(statuses []int, statusCode int) bool {
+	for _, status := range statuses {
+		if status == statusCode {
+			return true
 		}
-		return nil
 	}
+	return false
 }
 
 func httpClient() *http.Client {
@@ -337,7 +306,23 @@ func (c *Client) addHeaders(r *http.Request) {
 		r.Header.Add("accept", "application/json")
 		r.Header.Add("content-type", "application/json")
 	}
-	r.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	c.addDefaultHeaders(r)
+}
+
+// addDefaultHeaders add the headers sent with every request: the default headers, user agent & authorization (unless
+// the client has a token source, which authorizes each attempt as it is sent)
+func (c *Client) addDefaultHeaders(r *http.Request) {
+	for key, values := range c.headers {
+		if r.Header.Get(key) == "" {
+			r.Header[key] = append([]string(nil), values...)
+		}
+	}
+	if c.userAgent != "" {
+		r.Header.Set("User-Agent", c.userAgent)
+	}
+	if c.tokenSource == nil {
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	}
 }
 
 func payloadToBuffer(body interface{}) (io.ReadWriter, error) {
@@ -362,24 +347,6 @@ func createFormPart(fieldName, filename, contentType string, writer *multipart.W
 	return writer.CreatePart(h)
 }
 
-func constructErrorMsg(resp *http.Response) error {
-	respErr := &ResponseError{}
-	if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-		return errors.New(fmt.Sprintf("unexpected status code: %d", resp.StatusCode))
-	}
-
-	var errorDetails string
-	if len(respErr.Context.Fields) > 0 {
-		details := make([]string, 1)
-		for _, field := range respErr.Context.Fields {
-			details = append(details, fmt.Sprintf("%s: %s", field.Field, field.Message))
-		}
-		errorDetails = strings.Join(details, "\n  ")
-	}
-
-	return fmt.Errorf("unexpected status code: %d, message: %s (%s)%s", resp.StatusCode, respErr.Message, respErr.Code, errorDetails)
-}
-
 func constructURL(urlParts ...string) (string, error) {
 	for _, part := range urlParts {
 		if part == "" {
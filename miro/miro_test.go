@@ -2,14 +2,17 @@ package miro
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 )
@@ -258,3 +261,85 @@ func TestPayloadToBuffer(t *testing.T) {
 		})
 	})
 }
+
+// trackedBody a response body that records whether it was read to the end and closed
+type trackedBody struct {
+	io.Reader
+	drained bool
+	closed  bool
+}
+
+func (b *trackedBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		b.drained = true
+	}
+	return n, err
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		description string
+		method      string
+		statusCode  int
+		body        string
+		statuses    []int
+		expectError bool
+		expectName  string
+	}{
+		{description: "a GET with a 200 response", method: http.MethodGet, statusCode: http.StatusOK, body: `{"name":"gopher"}`, expectName: "gopher"},
+		{description: "a POST with a 200 response", method: http.MethodPost, statusCode: http.StatusOK, body: `{"name":"gopher"}`, expectName: "gopher"},
+		{description: "a POST with a 201 response", method: http.MethodPost, statusCode: http.StatusCreated, body: `{"name":"gopher"}`, expectName: "gopher"},
+		{description: "a PUT with a 202 response and an empty body", method: http.MethodPut, statusCode: http.StatusAccepted},
+		{description: "a PATCH with a 204 response", method: http.MethodPatch, statusCode: http.StatusNoContent},
+		{description: "a DELETE with a 200 response", method: http.MethodDelete, statusCode: http.StatusOK, body: `{}`},
+		{description: "a GET with a 201 response", method: http.MethodGet, statusCode: http.StatusCreated, body: `{"name":"gopher"}`, expectError: true},
+		{description: "a POST with a 404 response", method: http.MethodPost, statusCode: http.StatusNotFound, body: `{"message":"not found"}`, expectError: true},
+		{description: "a POST that only accepts a 204 response", method: http.MethodPost, statusCode: http.StatusOK, body: `{}`, statuses: []int{http.StatusNoContent}, expectError: true},
+	}
+
+	Convey("Given a request", t, func() {
+		for _, test := range tests {
+			Convey(fmt.Sprintf("When %s is executed", test.description), func() {
+				body := &trackedBody{Reader: strings.NewReader(test.body)}
+				client := NewClient(testToken, WithMiddleware(func(next RoundTripFunc) RoundTripFunc {
+					return func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode:    test.statusCode,
+							Header:        make(http.Header),
+							Body:          body,
+							ContentLength: int64(len(test.body)),
+						}, nil
+					}
+				}))
+
+				response := struct {
+					Name string `json:"name"`
+				}{}
+				err := client.execute(context.Background(), request{
+					method:   test.method,
+					url:      "http://no-where",
+					response: &response,
+					statuses: test.statuses,
+				})
+
+				Convey("Then the response is handled as expected and the body is drained & closed", func() {
+					if test.expectError {
+						So(err, ShouldHaveSameTypeAs, &ResponseError{})
+						So(err.(*ResponseError).StatusCode, ShouldEqual, test.statusCode)
+					} else {
+						So(err, ShouldBeNil)
+						So(response.Name, ShouldEqual, test.expectName)
+					}
+					So(body.drained, ShouldBeTrue)
+					So(body.closed, ShouldBeTrue)
+				})
+			})
+		}
+	})
+}
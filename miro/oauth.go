@@ -0,0 +1,321 @@
+package miro
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAuthURL the MIRO page where users authorize an app
+	DefaultAuthURL = "https://miro.com/oauth/authorize"
+	// DefaultTokenURL the MIRO endpoint that issues access tokens
+	DefaultTokenURL = "https://api.miro.com/v1/oauth/token"
+
+	// tokenExpiryDelta refresh tokens this long before they expire, so they don't expire in flight
+	tokenExpiryDelta = time.Minute
+)
+
+// ErrTokenExpired returned by a TokenSource when its token has expired and there is no refresh token to renew it with
+var ErrTokenExpired = errors.New("access token has expired and cannot be refreshed")
+
+// Token an OAuth 2.0 access token issued by MIRO, along with the refresh token used to renew it
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	UserID       string `json:"user_id,omitempty"`
+	TeamID       string `json:"team_id,omitempty"`
+	// ExpiresIn the lifetime of the access token in seconds, as issued
+	ExpiresIn int `json:"expires_in,omitempty"`
+	// Expiry the time the access token expires, calculated from ExpiresIn when the token is issued. A zero Expiry means
+	// the token doesn't expire.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token has an access token that hasn't expired (or is about to)
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry))
+}
+
+// TokenSource supplies the access token sent with each request, see WithTokenSource
+type TokenSource interface {
+	// Token returns a valid token, renewing it first if needed
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenRefresher is implemented by token sources that can renew their token on demand. When a request is rejected with
+// a 401, the client calls RefreshToken with the access token that was rejected, then retries the request once.
+type TokenRefresher interface {
+	RefreshToken(ctx context.Context, rejected string) (*Token, error)
+}
+
+// StaticTokenSource returns a TokenSource that always returns the same access token
+func StaticTokenSource(accessToken string) TokenSource {
+	return staticTokenSource{token: &Token{AccessToken: accessToken, TokenType: "Bearer"}}
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s staticTokenSource) Token(_ context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// WithTokenSource sets the source of the access token sent with every request, replacing the token passed to NewClient.
+// The source is consulted before every attempt of a request.
+func WithTokenSource(source TokenSource) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = source
+	}
+}
+
+// OAuthConfig the details of a MIRO app, used to authorize users via the OAuth 2.0 authorization code flow, e.g.
+//
+//	config := &miro.OAuthConfig{ClientID: "...", ClientSecret: "...", RedirectURL: "https://example.com/callback"}
+//	pkce, _ := miro.NewPKCE()
+//	http.Redirect(w, r, config.AuthCodeURL(state, pkce), http.StatusFound)
+//
+//	// in the callback handler
+//	token, err := config.Exchange(ctx, r.URL.Query().Get("code"), pkce)
+//	client := miro.NewClient("", miro.WithTokenSource(config.TokenSource(token)))
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	// RedirectURL the URL MIRO redirects the user to after they authorize the app, it must match the app's settings
+	RedirectURL string
+	// AuthURL Default: DefaultAuthURL
+	AuthURL string
+	// TokenURL Default: DefaultTokenURL
+	TokenURL string
+	// HTTPClient used to exchange & refresh tokens. Default: http.DefaultClient
+	HTTPClient *http.Client
+	// OnRefresh called with the new token every time a token source created by TokenSource refreshes its token. MIRO
+	// issues a new refresh token with every refresh, so use this to persist the latest token.
+	OnRefresh func(token *Token)
+}
+
+// PKCE a Proof Key for Code Exchange verifier & its S256 challenge, see NewPKCE
+type PKCE struct {
+	Verifier  string
+	Challenge string
+}
+
+// NewPKCE generates a random PKCE verifier and its challenge. The same PKCE must be passed to AuthCodeURL & Exchange, so
+// it has to be kept (e.g. in the user's session) until the user is redirected back to the app.
+func NewPKCE() (*PKCE, error) {
+	verifier := make([]byte, 32)
+	if _, err := rand.Read(verifier); err != nil {
+		return nil, err
+	}
+	return newPKCE(base64.RawURLEncoding.EncodeToString(verifier)), nil
+}
+
+func newPKCE(verifier string) *PKCE {
+	challenge := sha256.Sum256([]byte(verifier))
+	return &PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge[:]),
+	}
+}
+
+// AuthCodeURL returns the URL of the page where the user authorizes the app. state is returned unchanged in the redirect,
+// and should be checked to protect against CSRF. pkce is optional.
+func (o *OAuthConfig) AuthCodeURL(state string, pkce *PKCE) string {
+	params := url.Values{
+		"response_type": {"code"},
+		"client_id":     {o.ClientID},
+	}
+	if o.RedirectURL != "" {
+		params.Set("redirect_uri", o.RedirectURL)
+	}
+	if state != "" {
+		params.Set("state", state)
+	}
+	if pkce != nil {
+		params.Set("code_challenge", pkce.Challenge)
+		params.Set("code_challenge_method", "S256")
+	}
+
+	authURL := o.AuthURL
+	if authURL == "" {
+		authURL = DefaultAuthURL
+	}
+	if strings.Contains(authURL, "?") {
+		return authURL + "&" + params.Encode()
+	}
+	return authURL + "?" + params.Encode()
+}
+
+// Exchange the authorization code MIRO sent to the redirect URL for a token. pkce must be the same one passed to
+// AuthCodeURL, or nil if none was.
+func (o *OAuthConfig) Exchange(ctx context.Context, code string, pkce *PKCE) (*Token, error) {
+	params := url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {o.ClientID},
+		"client_secret": {o.ClientSecret},
+		"code":          {code},
+	}
+	if o.RedirectURL != "" {
+		params.Set("redirect_uri", o.RedirectURL)
+	}
+	if pkce != nil {
+		params.Set("code_verifier", pkce.Verifier)
+	}
+	return o.requestToken(ctx, params)
+}
+
+// Refresh exchange the refresh token for a new token
+func (o *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	return o.requestToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {o.ClientID},
+		"client_secret": {o.ClientSecret},
+		"refresh_token": {refreshToken},
+	})
+}
+
+// requestToken request a token from the token endpoint, MIRO expects the parameters in the query string
+func (o *OAuthConfig) requestToken(ctx context.Context, params url.Values) (*Token, error) {
+	tokenURL := o.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultTokenURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s?%s", tokenURL, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := o.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer drainAndClose(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	token := &Token{}
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("token response did not include an access token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// TokenSource returns a TokenSource that starts with the given token and refreshes it when it expires, or when the
+// MIRO API rejects it. The token source is safe for concurrent use, and is typically shared by a single client.
+func (o *OAuthConfig) TokenSource(token *Token) TokenSource {
+	return &refreshingTokenSource{config: o, token: token}
+}
+
+type refreshingTokenSource struct {
+	config *OAuthConfig
+	mu     sync.Mutex
+	token  *Token
+}
+
+func (s *refreshingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+func (s *refreshingTokenSource) RefreshToken(ctx context.Context, rejected string) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// another request has already replaced the rejected token
+	if s.token != nil && s.token.AccessToken != rejected && s.token.Valid() {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// refresh renew the token, the caller must hold the lock
+func (s *refreshingTokenSource) refresh(ctx context.Context) (*Token, error) {
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, ErrTokenExpired
+	}
+
+	token, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+	s.token = token
+
+	if s.config.OnRefresh != nil {
+		s.config.OnRefresh(token)
+	}
+	return token, nil
+}
+
+// authorize set the Authorization header of the request from the client's token source
+func (c *Client) authorize(req *http.Request) error {
+	token, err := c.tokenSource.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+	return nil
+}
+
+// doWithAuth send the request, refreshing the token & retrying the request once if it is rejected with a 401 and the
+// client's token source can refresh its token
+func (c *Client) doWithAuth(req *http.Request) (*http.Response, error) {
+	resp, err := c.doWithRetry(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	refresher, ok := c.tokenSource.(TokenRefresher)
+	if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, err
+	}
+
+	rejected := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if _, err := refresher.RefreshToken(req.Context(), rejected); err != nil {
+		// the original response is more useful to the caller than the failed refresh
+		return resp, nil
+	}
+	drainAndClose(resp.Body)
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+	return c.doWithRetry(req)
+}
@@ -0,0 +1,229 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockTokenEndpoint a stand-in for the MIRO token endpoint, issuing numbered tokens and recording the requests it receives
+func mockTokenEndpoint() (*OAuthConfig, *[]url.Values, func()) {
+	var mu sync.Mutex
+	var requests []url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		query := r.URL.Query()
+		requests = append(requests, query)
+
+		if query.Get("client_secret") != "test-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":401,"code":"unauthorized","message":"invalid client"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(Token{
+			AccessToken:  fmt.Sprintf("access-%d", len(requests)),
+			RefreshToken: fmt.Sprintf("refresh-%d", len(requests)),
+			TokenType:    "bearer",
+			ExpiresIn:    3600,
+		})
+	}))
+
+	config := &OAuthConfig{
+		ClientID:     "test-client",
+		ClientSecret: "test-secret",
+		RedirectURL:  "http://localhost/callback",
+		TokenURL:     server.URL + "/v1/oauth/token",
+	}
+	return config, &requests, server.Close
+}
+
+func TestNewPKCE(t *testing.T) {
+	Convey("Given the PKCE verifier from RFC 7636", t, func() {
+		Convey("When its challenge is calculated", func() {
+			pkce := newPKCE("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+
+			Convey("Then the challenge matches the one in the RFC", func() {
+				So(pkce.Challenge, ShouldEqual, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM")
+			})
+		})
+	})
+
+	Convey("Given no arguments", t, func() {
+		Convey("When NewPKCE is called twice", func() {
+			pkce1, err1 := NewPKCE()
+			pkce2, err2 := NewPKCE()
+
+			Convey("Then two different 43 character verifiers are generated", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(pkce1.Verifier, ShouldHaveLength, 43)
+				So(pkce1.Verifier, ShouldNotEqual, pkce2.Verifier)
+			})
+		})
+	})
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	Convey("Given an OAuth config", t, func() {
+		config := &OAuthConfig{ClientID: "test-client", RedirectURL: "http://localhost/callback"}
+
+		Convey("When AuthCodeURL is called with a state and a PKCE", func() {
+			pkce := newPKCE("verifier")
+			authURL, err := url.Parse(config.AuthCodeURL("test-state", pkce))
+
+			Convey("Then the URL points at the MIRO authorize page with the expected parameters", func() {
+				So(err, ShouldBeNil)
+				So(authURL.Scheme+"://"+authURL.Host+authURL.Path, ShouldEqual, DefaultAuthURL)
+				query := authURL.Query()
+				So(query.Get("response_type"), ShouldEqual, "code")
+				So(query.Get("client_id"), ShouldEqual, "test-client")
+				So(query.Get("redirect_uri"), ShouldEqual, "http://localhost/callback")
+				So(query.Get("state"), ShouldEqual, "test-state")
+				So(query.Get("code_challenge"), ShouldEqual, pkce.Challenge)
+				So(query.Get("code_challenge_method"), ShouldEqual, "S256")
+			})
+		})
+	})
+}
+
+func TestExchangeAndRefresh(t *testing.T) {
+	config, requests, closeServer := mockTokenEndpoint()
+	defer closeServer()
+
+	Convey("Given an authorization code", t, func() {
+		*requests = nil
+		pkce := newPKCE("verifier")
+
+		Convey("When the code is exchanged", func() {
+			token, err := config.Exchange(context.Background(), "test-code", pkce)
+
+			Convey("Then a token is returned with its expiry", func() {
+				So(err, ShouldBeNil)
+				So(token.AccessToken, ShouldEqual, "access-1")
+				So(token.RefreshToken, ShouldEqual, "refresh-1")
+				So(token.Expiry, ShouldHappenWithin, time.Minute, time.Now().Add(time.Hour))
+				So(token.Valid(), ShouldBeTrue)
+
+				Convey("And the request contains the code, verifier & client credentials", func() {
+					query := (*requests)[0]
+					So(query.Get("grant_type"), ShouldEqual, "authorization_code")
+					So(query.Get("code"), ShouldEqual, "test-code")
+					So(query.Get("code_verifier"), ShouldEqual, "verifier")
+					So(query.Get("client_id"), ShouldEqual, "test-client")
+					So(query.Get("redirect_uri"), ShouldEqual, "http://localhost/callback")
+				})
+			})
+
+			Convey("And the token is refreshed", func() {
+				refreshed, err := config.Refresh(context.Background(), token.RefreshToken)
+
+				Convey("Then a new token is returned", func() {
+					So(err, ShouldBeNil)
+					So(refreshed.AccessToken, ShouldEqual, "access-2")
+					So((*requests)[1].Get("grant_type"), ShouldEqual, "refresh_token")
+					So((*requests)[1].Get("refresh_token"), ShouldEqual, "refresh-1")
+				})
+			})
+		})
+
+		Convey("When the code is exchanged with the wrong client secret", func() {
+			badConfig := *config
+			badConfig.ClientSecret = "wrong"
+			_, err := badConfig.Exchange(context.Background(), "test-code", pkce)
+
+			Convey("Then a ResponseError is returned", func() {
+				So(IsUnauthorized(err), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestTokenSource(t *testing.T) {
+	config, requests, closeServer := mockTokenEndpoint()
+	defer closeServer()
+
+	var refreshed []*Token
+	config.OnRefresh = func(token *Token) {
+		refreshed = append(refreshed, token)
+	}
+
+	var receivedTokens []string
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "")
+	defer closeAPIServer()
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		receivedTokens = append(receivedTokens, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "Bearer revoked" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(Board{ID: testBoardID})
+	})
+
+	Convey("Given a client with a refreshing token source", t, func() {
+		*requests, refreshed, receivedTokens = nil, nil, nil
+
+		Convey("When a request is made with a valid token", func() {
+			client.tokenSource = config.TokenSource(&Token{AccessToken: "valid", RefreshToken: "refresh-0", Expiry: time.Now().Add(time.Hour)})
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the token is sent without being refreshed", func() {
+				So(err, ShouldBeNil)
+				So(receivedTokens, ShouldResemble, []string{"Bearer valid"})
+				So(*requests, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a request is made with an expired token", func() {
+			client.tokenSource = config.TokenSource(&Token{AccessToken: "expired", RefreshToken: "refresh-0", Expiry: time.Now().Add(-time.Minute)})
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the token is refreshed before the request is sent", func() {
+				So(err, ShouldBeNil)
+				So(receivedTokens, ShouldResemble, []string{"Bearer access-1"})
+				So((*requests)[0].Get("refresh_token"), ShouldEqual, "refresh-0")
+				So(refreshed, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When a request is rejected with a 401", func() {
+			client.tokenSource = config.TokenSource(&Token{AccessToken: "revoked", RefreshToken: "refresh-0"})
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the token is refreshed and the request is retried", func() {
+				So(err, ShouldBeNil)
+				So(receivedTokens, ShouldResemble, []string{"Bearer revoked", "Bearer access-1"})
+				So(refreshed, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When a request is made with an expired token that can't be refreshed", func() {
+			client.tokenSource = config.TokenSource(&Token{AccessToken: "expired", Expiry: time.Now().Add(-time.Minute)})
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then ErrTokenExpired is returned without the request being sent", func() {
+				So(errors.Is(err, ErrTokenExpired), ShouldBeTrue)
+				So(receivedTokens, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a request is rejected with a 401 and the token source can't refresh", func() {
+			client.tokenSource = StaticTokenSource("revoked")
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the 401 is returned", func() {
+				So(IsUnauthorized(err), ShouldBeTrue)
+				So(receivedTokens, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type OEmbedServices struct {
 	client     *Client
 	apiVersion string
@@ -10,6 +12,11 @@ type OEmbedServices struct {
 // The URL is the resource to return as oEmbed data. Currently, it supports only URLs pointing to Miro boards.
 // OEmbed params: OEmbedParams{}
 func (o *OEmbedServices) Get(URL string, queryParams ...OEmbedParams) (*OEmbed, error) {
+	return o.GetWithContext(o.client.ctx, URL, queryParams...)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (o *OEmbedServices) GetWithContext(ctx context.Context, URL string, queryParams ...OEmbedParams) (*OEmbed, error) {
 	response := &OEmbed{}
 
 	if url, err := constructURL(o.client.BaseURL, o.apiVersion, o.resource); err != nil {
@@ -17,11 +24,13 @@ func (o *OEmbedServices) Get(URL string, queryParams ...OEmbedParams) (*OEmbed,
 	} else {
 		var searchParams []Parameter
 		if len(queryParams) > 0 {
-			searchParams = parseQueryTags(queryParams[0])
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
 		}
 		searchParams = append(searchParams, Parameter{"url": URL})
 
-		err = o.client.Get(o.client.ctx, url, response, searchParams...)
+		err = o.client.Get(ctx, url, response, searchParams...)
 		return response, err
 	}
 }
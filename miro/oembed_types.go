@@ -13,6 +13,17 @@ type OEmbed struct {
 	Height          int    `json:"height"`
 	ProviderName    string `json:"provider_name"`
 	ProviderURL     string `json:"provider_url"`
+	Extra           Extra  `json:"-"`
+}
+
+func (o *OEmbed) UnmarshalJSON(data []byte) error {
+	type oEmbed OEmbed
+	return unmarshalWithExtra(data, (*oEmbed)(o), &o.Extra)
+}
+
+func (o OEmbed) MarshalJSON() ([]byte, error) {
+	type oEmbed OEmbed
+	return marshalWithExtra(oEmbed(o), o.Extra)
 }
 
 type OEmbedFormat string
@@ -25,12 +36,12 @@ const (
 type OEmbedParams struct {
 	// Format Specifies the return format of the response. It complies with the oEmbed standard.
 	// Allowed formats: either "json", or "xml".
-	Format OEmbedFormat `query:"format"`
+	Format OEmbedFormat `query:"format,omitempty"`
 	// Referrer The URL pointing to the source of the request.
 	// Service providers such as Embedly use it to forward the initial site that triggered the oEmbed request.
-	Referrer string `query:"referrer"`
+	Referrer string `query:"referrer,omitempty"`
 	// MaxWidth The maximum width available to the embed, in pixels.
-	MaxWidth int32 `query:"maxwidth"`
+	MaxWidth int32 `query:"maxwidth,omitempty"`
 	// MaxHeight The maximum height available to the embed, in pixels.
-	MaxHeight int32 `query:"maxheight"`
+	MaxHeight int32 `query:"maxheight,omitempty"`
 }
@@ -0,0 +1,173 @@
+package miro
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// APIVersion a version of the MIRO API
+type APIVersion string
+
+const (
+	APIVersionV1 APIVersion = "v1"
+	APIVersionV2 APIVersion = "v2"
+	// APIVersionV2Experimental the experimental version of the MIRO API, with features (e.g. flowchart shapes, mind
+	// maps & webhooks) whose endpoints & types may change
+	APIVersionV2Experimental APIVersion = "v2-experimental"
+)
+
+// knownAPIVersions the versions of the MIRO API
+var knownAPIVersions = map[APIVersion]bool{APIVersionV1: true, APIVersionV2: true, APIVersionV2Experimental: true}
+
+// APIResource the resource in the path of the endpoints of a service, which identifies the service in WithAPIVersion
+type APIResource string
+
+const (
+	APIResourceBoards       APIResource = "boards"
+	APIResourceBoardMembers APIResource = "members"
+	APIResourceItems        APIResource = "items"
+	APIResourceAppCards     APIResource = "app_cards"
+	APIResourceCards        APIResource = "cards"
+	APIResourceShapes       APIResource = "shapes"
+	APIResourceConnectors   APIResource = "connectors"
+	APIResourceDocuments    APIResource = "documents"
+	APIResourceEmbeds       APIResource = "embeds"
+	APIResourceFrames       APIResource = "frames"
+	APIResourceImages       APIResource = "images"
+	APIResourceStickyNotes  APIResource = "sticky_notes"
+	APIResourceTexts        APIResource = "texts"
+	APIResourceTags         APIResource = "tags"
+	APIResourceGroups       APIResource = "groups"
+	APIResourceMindmapNodes APIResource = "mindmap_nodes"
+	APIResourceWebhooks     APIResource = "webhooks"
+	APIResourceOEmbed       APIResource = "oembed"
+)
+
+// apiResources the resources of the services built by buildAPIMap
+var apiResources = map[APIResource]bool{
+	APIResourceBoards: true, APIResourceBoardMembers: true, APIResourceItems: true, APIResourceAppCards: true,
+	APIResourceCards: true, APIResourceShapes: true, APIResourceConnectors: true, APIResourceDocuments: true,
+	APIResourceEmbeds: true, APIResourceFrames: true, APIResourceImages: true, APIResourceStickyNotes: true,
+	APIResourceTexts: true, APIResourceTags: true, APIResourceGroups: true, APIResourceMindmapNodes: true,
+	APIResourceWebhooks: true, APIResourceOEmbed: true,
+}
+
+// ClientOption configures a Client, see NewClient
+type ClientOption func(c *Client)
+
+// RoundTripFunc sends a request and returns its response
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps every request sent by the client and its services. A middleware can modify the request before
+// calling next, and inspect or modify the response (or error) returned by next.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithBaseURL sets the base URL of the MIRO API. Default: https://api.miro.com (or the MIRO_MOCK_SERVER environment variable)
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.BaseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeaders sets headers that are sent with every request. Headers set by the client itself, such as Authorization
+// or Content-Type, take precedence.
+func WithHeaders(headers http.Header) ClientOption {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		for key, values := range headers {
+			for _, value := range values {
+				c.headers.Add(key, value)
+			}
+		}
+	}
+}
+
+// WithMiddleware appends middleware to the client's middleware chain. Middleware is called in the order it is added,
+// so the first middleware added is the first to see each request and the last to see each response.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client, see RetryPolicy
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithRateLimiter sets the credit limiter of the client, see CreditLimiter
+func WithRateLimiter(limiter *CreditLimiter) ClientOption {
+	return func(c *Client) {
+		c.RateLimiter = limiter
+	}
+}
+
+// WithValidation validates the payload of every request before it is sent (see the Validate methods of the payloads),
+// so that requests that are bound to fail return a *ValidationError without spending any credits
+func WithValidation() ClientOption {
+	return func(c *Client) {
+		c.validate = true
+	}
+}
+
+// WithAPIVersion sets the version of the MIRO API used by the service of the resource, e.g.
+// WithAPIVersion(APIResourceShapes, APIVersionV2Experimental) for the ShapeItems service to create flowchart shapes.
+// Each resource sets the version of its own service only: APIResourceBoards sets the version of the Boards service, not
+// of the services of the items on the boards. If the resource isn't one of the APIResource constants, or the version
+// one of the APIVersion constants, the client returns the error from every call (and from Client.Err) rather than
+// ignoring the version.
+func WithAPIVersion(resource APIResource, version APIVersion) ClientOption {
+	return func(c *Client) {
+		if !apiResources[resource] {
+			c.addOptionErr(fmt.Errorf("miro: unknown API resource %q", resource))
+			return
+		}
+		if !knownAPIVersions[version] {
+			c.addOptionErr(fmt.Errorf("miro: unknown API version %q for resource %q", version, resource))
+			return
+		}
+
+		if c.apiVersions == nil {
+			c.apiVersions = make(map[APIResource]APIVersion)
+		}
+		c.apiVersions[resource] = version
+	}
+}
+
+// addOptionErr keep the first error of the options the client was created with
+func (c *Client) addOptionErr(err error) {
+	if c.optionErr == nil {
+		c.optionErr = err
+	}
+}
+
+// roundTrip build the middleware chain around the HTTP client, with the request logging closest to the HTTP client so
+// that the requests are logged as they are sent
+func (c *Client) roundTrip() RoundTripFunc {
+	next := RoundTripFunc(c.HTTPClient.Do)
+	if c.logger != nil && c.logLevel > LogLevelNone {
+		next = c.logRequests(next)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		next = c.middleware[i](next)
+	}
+	return next
+}
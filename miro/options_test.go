@@ -0,0 +1,185 @@
+package miro
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	Convey("Given a set of client options", t, func() {
+		httpClient := &http.Client{Timeout: time.Second}
+		retryPolicy := DefaultRetryPolicy()
+		limiter := NewCreditLimiter(DefaultCreditsPerMinute, false)
+
+		Convey("When NewClient is called with the options", func() {
+			client := NewClient(testToken,
+				WithBaseURL("http://localhost:1234"),
+				WithHTTPClient(httpClient),
+				WithUserAgent("miro-gopher-test"),
+				WithHeaders(http.Header{"X-Team": []string{"gophers"}}),
+				WithRetryPolicy(retryPolicy),
+				WithRateLimiter(limiter),
+			)
+
+			Convey("Then the client is configured with the options", func() {
+				So(client.BaseURL, ShouldEqual, "http://localhost:1234")
+				So(client.HTTPClient, ShouldEqual, httpClient)
+				So(client.userAgent, ShouldEqual, "miro-gopher-test")
+				So(client.headers.Get("X-Team"), ShouldEqual, "gophers")
+				So(client.RetryPolicy, ShouldEqual, retryPolicy)
+				So(client.RateLimiter, ShouldEqual, limiter)
+				So(client.Boards.client, ShouldEqual, client)
+			})
+		})
+	})
+}
+
+func TestClientMiddleware(t *testing.T) {
+	var receivedRequest *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedRequest = r
+		json.NewEncoder(w).Encode(Board{ID: testBoardID})
+	}))
+	defer server.Close()
+
+	var calls []string
+	tracer := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, fmt.Sprintf("%s request", name))
+				req.Header.Set(fmt.Sprintf("X-%s", name), "true")
+				resp, err := next(req)
+				calls = append(calls, fmt.Sprintf("%s response %d", name, resp.StatusCode))
+				return resp, err
+			}
+		}
+	}
+
+	client := NewClient(testToken,
+		WithBaseURL(server.URL),
+		WithUserAgent("miro-gopher-test"),
+		WithHeaders(http.Header{"X-Team": []string{"gophers"}, "Authorization": []string{"Bearer overridden"}}),
+		WithMiddleware(tracer("First"), tracer("Second")),
+	)
+
+	Convey("Given a client with a middleware chain", t, func() {
+		Convey("When a service method is called", func() {
+			results, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the middleware wraps the request in the order it was added", func() {
+				So(err, ShouldBeNil)
+				So(results.ID, ShouldEqual, testBoardID)
+				So(calls, ShouldResemble, []string{
+					"First request",
+					"Second request",
+					"Second response 200",
+					"First response 200",
+				})
+
+				Convey("And the request contains the headers set by the middleware & options", func() {
+					So(receivedRequest.Header.Get("X-First"), ShouldEqual, "true")
+					So(receivedRequest.Header.Get("X-Second"), ShouldEqual, "true")
+					So(receivedRequest.Header.Get("X-Team"), ShouldEqual, "gophers")
+					So(receivedRequest.Header.Get("User-Agent"), ShouldEqual, "miro-gopher-test")
+					So(receivedRequest.Header.Get("Authorization"), ShouldEqual, fmt.Sprintf("Bearer %s", testToken))
+				})
+			})
+		})
+	})
+}
+
+func TestWithAPIVersion(t *testing.T) {
+	var receivedRequest *http.Request
+	var body SetShapeItem
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedRequest = r
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "` + testItemID + `", "data": {"shape": "flow_chart_decision"}}`))
+	}))
+	defer server.Close()
+
+	Convey("Given a client that uses the experimental API for shapes", t, func() {
+		client := NewClient(testToken, WithBaseURL(server.URL), WithAPIVersion(APIResourceShapes, APIVersionV2Experimental), WithValidation())
+
+		Convey("When a flowchart shape is created", func() {
+			shape, err := client.ShapeItems.Create(testBoardID, SetShapeItem{Data: ShapeItemData{Shape: ShapeFlowChartDecision}})
+
+			Convey("Then it is sent to the experimental shapes endpoint", func() {
+				So(err, ShouldBeNil)
+				So(receivedRequest.URL.Path, ShouldEqual, fmt.Sprintf("/v2-experimental/boards/%s/shapes", testBoardID))
+				So(body.Data.Shape, ShouldEqual, ShapeFlowChartDecision)
+				So(shape.Data.Shape, ShouldEqual, ShapeFlowChartDecision)
+			})
+		})
+
+		Convey("When the other services are used", func() {
+			_, err := client.StickyNotes.Create(testBoardID, StickyNoteSet{})
+
+			Convey("Then they keep their default version", func() {
+				So(err, ShouldBeNil)
+				So(receivedRequest.URL.Path, ShouldEqual, fmt.Sprintf("/v2/boards/%s/sticky_notes", testBoardID))
+			})
+		})
+	})
+
+	Convey("Given a client without API versions set", t, func() {
+		client := NewClient(testToken, WithBaseURL(server.URL), WithValidation())
+
+		Convey("Then each service uses its default version", func() {
+			So(client.ShapeItems.apiVersion, ShouldEqual, "v2")
+			So(client.MindmapNodes.apiVersion, ShouldEqual, "v2-experimental")
+			So(client.OEmbed.apiVersion, ShouldEqual, "v1")
+		})
+
+		Convey("When a flowchart shape is created", func() {
+			receivedRequest = nil
+			_, err := client.ShapeItems.Create(testBoardID, SetShapeItem{Data: ShapeItemData{Shape: ShapeFlowChartDecision}})
+
+			Convey("Then it is rejected without being sent, as v2 doesn't support flowchart shapes", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `data.shape: "flow_chart_decision" is only supported by the v2-experimental API`)
+				So(receivedRequest, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a resource that isn't one of the services", t, func() {
+		client := NewClient(testToken, WithBaseURL(server.URL), WithAPIVersion("shape", APIVersionV2Experimental))
+
+		Convey("When the client is used", func() {
+			receivedRequest = nil
+			_, err := client.StickyNotes.Create(testBoardID, StickyNoteSet{})
+
+			Convey("Then the option's error is returned rather than the version being ignored", func() {
+				So(client.Err(), ShouldNotBeNil)
+				So(client.Err().Error(), ShouldEqual, `miro: unknown API resource "shape"`)
+				So(err, ShouldEqual, client.Err())
+				So(receivedRequest, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a version that isn't one of the versions of the MIRO API", t, func() {
+		client := NewClient(testToken, WithBaseURL(server.URL), WithAPIVersion(APIResourceShapes, "v3"))
+
+		Convey("Then the client reports it", func() {
+			So(client.Err(), ShouldNotBeNil)
+			So(client.Err().Error(), ShouldEqual, `miro: unknown API version "v3" for resource "shapes"`)
+			So(client.ShapeItems.apiVersion, ShouldEqual, "v2")
+		})
+	})
+
+	Convey("Given valid API versions", t, func() {
+		client := NewClient(testToken, WithAPIVersion(APIResourceBoards, APIVersionV1))
+
+		Convey("Then the client has no error", func() {
+			So(client.Err(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,196 @@
+package miro
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// offsetPage the pagination details of a page of an offset-paginated list
+type offsetPage struct {
+	links  *PaginationLinks
+	offset int
+	limit  int
+	size   int
+	total  int
+}
+
+// nextPageURL returns the URL of the page after the given page, or an empty string if it is the last page. The next link
+// sent with the page is used when there is one, with its URI template expanded using the search parameters of the
+// first page. If the page has no links at all, the offset of the next page is calculated from the page's offset, size
+// & total instead.
+func nextPageURL(url string, page offsetPage, searchParams []Parameter) (string, error) {
+	values := make(map[string]string)
+	for _, params := range searchParams {
+		for key, value := range params {
+			values[key] = value
+		}
+	}
+
+	if page.links != nil && page.links.Next != "" {
+		// the link already points at the next page, so the offset of the first page mustn't be expanded into it
+		delete(values, "offset")
+		return expandURITemplate(page.links.Next, values)
+	}
+	if (page.links != nil && !page.links.empty()) || page.size == 0 || page.offset+page.size >= page.total {
+		return "", nil
+	}
+
+	values["offset"] = strconv.Itoa(page.offset + page.size)
+	return url + encodeQueryParams([]Parameter{values}), nil
+}
+
+// uriTemplateOperators the expansion rules of the RFC 6570 operators: the prefix of the expansion, the separator
+// between values, whether the values are named, the string used for named values that are empty and whether reserved
+// characters are left unencoded
+var uriTemplateOperators = map[byte]struct {
+	prefix, separator string
+	named             bool
+	ifEmpty           string
+	allowReserved     bool
+}{
+	0:   {prefix: "", separator: ","},
+	'+': {prefix: "", separator: ",", allowReserved: true},
+	'#': {prefix: "#", separator: ",", allowReserved: true},
+	'.': {prefix: ".", separator: "."},
+	'/': {prefix: "/", separator: "/"},
+	';': {prefix: ";", separator: ";", named: true},
+	'?': {prefix: "?", separator: "&", named: true, ifEmpty: "="},
+	'&': {prefix: "&", separator: "&", named: true, ifEmpty: "="},
+}
+
+// expandURITemplate expand an RFC 6570 URI template (up to level 3, plus the prefix modifier), such as the links MIRO
+// sends with each page, e.g. https://api.miro.com/v2/boards?limit=20&offset=20{&team_id,query}. Variables without a
+// value are left out.
+func expandURITemplate(template string, values map[string]string) (string, error) {
+	var sb strings.Builder
+
+	for {
+		start := strings.IndexByte(template, '{')
+		if start == -1 {
+			sb.WriteString(template)
+			return sb.String(), nil
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end == -1 {
+			return "", fmt.Errorf("unterminated expression in URI template %q", template)
+		}
+		end += start
+
+		sb.WriteString(template[:start])
+		expansion, err := expandURITemplateExpression(template[start+1:end], values)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(expansion)
+		template = template[end+1:]
+	}
+}
+
+func expandURITemplateExpression(expression string, values map[string]string) (string, error) {
+	var operator byte
+	if expression != "" {
+		if _, ok := uriTemplateOperators[expression[0]]; ok {
+			operator = expression[0]
+			expression = expression[1:]
+		}
+	}
+	op := uriTemplateOperators[operator]
+
+	var expanded []string
+	for _, variable := range strings.Split(expression, ",") {
+		name, maxLength := variable, -1
+		if idx := strings.IndexByte(variable, ':'); idx != -1 {
+			length, err := strconv.Atoi(variable[idx+1:])
+			if err != nil || length <= 0 {
+				return "", fmt.Errorf("invalid prefix modifier in URI template expression %q", variable)
+			}
+			name, maxLength = variable[:idx], length
+		}
+		// an explode modifier makes no difference to a string value
+		name = strings.TrimSuffix(name, "*")
+		if name == "" {
+			return "", fmt.Errorf("empty variable name in URI template expression %q", expression)
+		}
+
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		if runes := []rune(value); maxLength != -1 && len(runes) > maxLength {
+			value = string(runes[:maxLength])
+		}
+
+		value = encodeURITemplateValue(value, op.allowReserved)
+		switch {
+		case !op.named:
+			expanded = append(expanded, value)
+		case value == "":
+			expanded = append(expanded, name+op.ifEmpty)
+		default:
+			expanded = append(expanded, name+"="+value)
+		}
+	}
+
+	if len(expanded) == 0 {
+		return "", nil
+	}
+	return op.prefix + strings.Join(expanded, op.separator), nil
+}
+
+// encodeURITemplateValue percent-encode everything but the unreserved characters, and the reserved characters & existing
+// percent-encoded triplets if allowReserved is set
+func encodeURITemplateValue(value string, allowReserved bool) string {
+	const hex = "0123456789ABCDEF"
+
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', strings.IndexByte("-._~", c) != -1:
+			sb.WriteByte(c)
+		case allowReserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) != -1:
+			sb.WriteByte(c)
+		case allowReserved && c == '%' && i+2 < len(value) && isHex(value[i+1]) && isHex(value[i+2]):
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteByte(hex[c>>4])
+			sb.WriteByte(hex[c&0x0f])
+		}
+	}
+	return sb.String()
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// newOffsetIterator create an iterator over an offset-paginated list of type L, fetching the first page from url with
+// the search params, and each following page from the URL returned by nextPageURL. page returns the items & pagination
+// details of a fetched list. If setupErr isn't nil (e.g. the URL couldn't be built), it is returned instead of the first
+// page.
+func newOffsetIterator[T, L any](ctx context.Context, client *Client, level RateLimitLevel, url string, setupErr error,
+	searchParams []Parameter, page func(list *L) ([]T, offsetPage)) *Iterator[T] {
+	return newIterator(ctx, func(ctx context.Context, next string) ([]T, string, error) {
+		if setupErr != nil {
+			return nil, "", setupErr
+		}
+
+		list := new(L)
+		var err error
+		if next == "" {
+			err = client.Get(withRateLimitLevel(ctx, level), url, list, searchParams...)
+		} else {
+			err = client.Get(withRateLimitLevel(ctx, level), next, list)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		items, details := page(list)
+		next, err = nextPageURL(url, details, searchParams)
+		return items, next, err
+	})
+}
@@ -0,0 +1,226 @@
+package miro
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestExpandURITemplate(t *testing.T) {
+	values := map[string]string{
+		"var":   "value",
+		"hello": "Hello World!",
+		"path":  "/foo/bar",
+		"x":     "1024",
+		"y":     "768",
+		"empty": "",
+	}
+
+	// examples from RFC 6570
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{template: "{var}", expected: "value"},
+		{template: "{hello}", expected: "Hello%20World%21"},
+		{template: "{+path}/here", expected: "/foo/bar/here"},
+		{template: "{#path}", expected: "#/foo/bar"},
+		{template: "map?{x,y}", expected: "map?1024,768"},
+		{template: "X{.var}", expected: "X.value"},
+		{template: "{/var,x}/here", expected: "/value/1024/here"},
+		{template: "{;x,y,empty}", expected: ";x=1024;y=768;empty"},
+		{template: "{?x,y,empty}", expected: "?x=1024&y=768&empty="},
+		{template: "?fixed=yes{&x}", expected: "?fixed=yes&x=1024"},
+		{template: "{var:3}", expected: "val"},
+		{template: "{?undefined}", expected: ""},
+		{template: "{?x,undefined,y}", expected: "?x=1024&y=768"},
+		{template: "https://api.miro.com/v2/boards?limit=20&offset=20{&team_id,query}", expected: "https://api.miro.com/v2/boards?limit=20&offset=20"},
+	}
+
+	Convey("Given a set of values", t, func() {
+		for _, test := range tests {
+			Convey(fmt.Sprintf("When the URI template %s is expanded", test.template), func() {
+				result, err := expandURITemplate(test.template, values)
+
+				Convey("Then the expected URI is returned", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldEqual, test.expected)
+				})
+			})
+		}
+
+		Convey("When an invalid URI template is expanded", func() {
+			_, err := expandURITemplate("https://api.miro.com/v2/boards{?limit", values)
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestNextPageURL(t *testing.T) {
+	searchParams := []Parameter{{"team_id": "gophers", "offset": "0", "limit": "2"}}
+
+	Convey("Given a page of an offset-paginated list", t, func() {
+		Convey("When the page has a templated next link", func() {
+			url, err := nextPageURL("http://localhost/v2/boards", offsetPage{
+				links: &PaginationLinks{Next: "http://localhost/v2/boards?offset=2{&team_id,limit,offset}"},
+			}, searchParams)
+
+			Convey("Then the template is expanded with the search params, leaving the link's offset as it is", func() {
+				So(err, ShouldBeNil)
+				So(url, ShouldEqual, "http://localhost/v2/boards?offset=2&team_id=gophers&limit=2")
+			})
+		})
+
+		Convey("When the page has links but no next link", func() {
+			url, err := nextPageURL("http://localhost/v2/boards", offsetPage{
+				links: &PaginationLinks{Self: "http://localhost/v2/boards"}, offset: 0, size: 2, total: 4,
+			}, searchParams)
+
+			Convey("Then it is the last page", func() {
+				So(err, ShouldBeNil)
+				So(url, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the page has no links", func() {
+			url, err := nextPageURL("http://localhost/v2/boards", offsetPage{offset: 0, size: 2, total: 4}, searchParams)
+
+			Convey("Then the URL of the next page is calculated from the offset, size & total", func() {
+				So(err, ShouldBeNil)
+				So(url, ShouldEqual, "http://localhost/v2/boards?limit=2&offset=2&team_id=gophers")
+			})
+		})
+
+		Convey("When the page has no links and is the last page", func() {
+			url, err := nextPageURL("http://localhost/v2/boards", offsetPage{offset: 2, size: 2, total: 4}, searchParams)
+
+			Convey("Then an empty URL is returned", func() {
+				So(err, ShouldBeNil)
+				So(url, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+// mockOffsetPages serve 5 boards in pages of 2, with a templated next link like the ones sent by MIRO
+func mockOffsetPages(serverURL *string, path string, requests *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		response := ListBoards{Offset: offset, Total: 5, Links: &PaginationLinks{}}
+		for i := offset; i < offset+2 && i < 5; i++ {
+			response.Data = append(response.Data, &Board{ID: fmt.Sprintf("board-%d", i)})
+		}
+		response.Size = len(response.Data)
+		if offset+2 < 5 {
+			response.Links.Next = fmt.Sprintf("%s%s?offset=%d{&team_id,limit}", *serverURL, path, offset+2)
+		}
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+func TestBoardsIterate(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, "", "")
+	defer closeAPIServer()
+
+	var requests []string
+	mux.HandleFunc(testResourcePath, mockOffsetPages(&client.BaseURL, testResourcePath, &requests))
+
+	Convey("Given 5 boards, returned 2 per page", t, func() {
+		requests = nil
+
+		Convey("When all the boards are requested from the iterator", func() {
+			boards, err := client.Boards.Iterate(BoardSearchParams{TeamID: "gophers", Limit: "2"}).All()
+
+			Convey("Then every board is returned, following the next links", func() {
+				So(err, ShouldBeNil)
+				So(boards, ShouldHaveLength, 5)
+				So(boards[4].ID, ShouldEqual, "board-4")
+				So(requests, ShouldResemble, []string{"limit=2&team_id=gophers", "offset=2&team_id=gophers&limit=2", "offset=4&team_id=gophers&limit=2"})
+			})
+		})
+
+		Convey("When the pages are fetched with ListBoards.GetNext", func() {
+			list, err := client.Boards.GetAll(BoardSearchParams{TeamID: "gophers", Limit: "2"})
+			So(err, ShouldBeNil)
+
+			var ids []string
+			for {
+				boards, err := list.GetNext()
+				if err == IteratorDone {
+					break
+				}
+				So(err, ShouldBeNil)
+				for _, board := range boards.Data {
+					ids = append(ids, board.ID)
+				}
+			}
+
+			Convey("Then every board is returned", func() {
+				So(ids, ShouldResemble, []string{"board-0", "board-1", "board-2", "board-3", "board-4"})
+				So(requests, ShouldHaveLength, 3)
+			})
+		})
+	})
+}
+
+func TestBoardMembersIterate(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "members")
+	defer closeAPIServer()
+
+	var offsets []string
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		member := &BoardMember{BasicEntityInfo: BasicEntityInfo{ID: fmt.Sprintf("member-%d", offset)}}
+		// no links are sent, so the offset of each page has to be calculated
+		json.NewEncoder(w).Encode(ListBoardMembers{Data: []*BoardMember{member}, Offset: offset, Size: 1, Total: 3})
+	})
+
+	Convey("Given a board with 3 members, returned 1 per page", t, func() {
+		Convey("When all the members are requested from the iterator", func() {
+			members, err := client.BoardMembers.Iterate(testBoardID).All()
+
+			Convey("Then every member is returned", func() {
+				So(err, ShouldBeNil)
+				So(members, ShouldHaveLength, 3)
+				So(members[2].ID, ShouldEqual, "member-2")
+				So(offsets, ShouldResemble, []string{"", "1", "2"})
+			})
+		})
+	})
+}
+
+func TestTagsIterateTagsFromBoard(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "tags")
+	defer closeAPIServer()
+
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		response := ListBoardTags{Data: []Tag{{ID: "tag-0"}}, Size: 1, Total: 2}
+		if r.URL.Query().Get("offset") == "1" {
+			response = ListBoardTags{Data: []Tag{{ID: "tag-1"}}, Offset: 1, Size: 1, Total: 2}
+		} else {
+			response.Links.Next = client.BaseURL + testResourcePath + "?offset=1{&limit}"
+		}
+		json.NewEncoder(w).Encode(response)
+	})
+
+	Convey("Given a board with 2 tags, returned 1 per page", t, func() {
+		Convey("When all the tags are requested from the iterator", func() {
+			tags, err := client.Tags.IterateTagsFromBoard(testBoardID, TagSearchParams{Limit: "1"}).All()
+
+			Convey("Then both tags are returned", func() {
+				So(err, ShouldBeNil)
+				So(tags, ShouldHaveLength, 2)
+				So(tags[1].ID, ShouldEqual, "tag-1")
+			})
+		})
+	})
+}
@@ -1,10 +1,13 @@
 package miro
 
 import (
+	"encoding"
 	"fmt"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Parameter map[string]string
@@ -15,6 +18,18 @@ type tags struct {
 	required  bool
 }
 
+// QueryMarshaler is implemented by types that encode themselves as a query parameter value. An empty value is treated
+// as a zero value by the omitempty & required tag options.
+type QueryMarshaler interface {
+	MarshalQuery() (string, error)
+}
+
+var (
+	queryMarshalerType = reflect.TypeOf((*QueryMarshaler)(nil)).Elem()
+	textMarshalerType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType           = reflect.TypeOf(time.Time{})
+)
+
 func encodeQueryParams(queryParams []Parameter) string {
 	values := url.Values{}
 	for _, params := range queryParams {
@@ -28,24 +43,145 @@ func encodeQueryParams(queryParams []Parameter) string {
 	return "?" + values.Encode()
 }
 
-// parseQueryTags parse "query" tags in struct and return them in a slice of Parameter with their tag names as the keys
-func parseQueryTags(v interface{}) []Parameter {
+// encodeQueryTags encode the "query" tagged fields of a struct (or a pointer to a struct) as parameters, with their tag
+// names as the keys. The tag options are:
+//
+//   - omitempty leaves the field out when it has its zero value
+//   - required returns an error when the field has its zero value
+//
+// Strings, bools, ints, uints, floats, time.Time (as RFC 3339), slices & arrays (comma separated), pointers to any of
+// these, and types that implement QueryMarshaler or encoding.TextMarshaler are supported. Untagged embedded structs
+// are encoded as if their fields were part of the outer struct.
+func encodeQueryTags(v interface{}) ([]Parameter, error) {
 	params := make([]Parameter, 0)
-	t := reflect.TypeOf(v)
+
 	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return params, nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("query parameters must be a struct, not %T", v)
+	}
+
+	// copy the struct, so that its fields are addressable for types that implement QueryMarshaler on a pointer receiver
+	addressable := reflect.New(value.Type()).Elem()
+	addressable.Set(value)
+	return appendQueryTags(params, addressable)
+}
+
+func appendQueryTags(params []Parameter, value reflect.Value) ([]Parameter, error) {
+	t := value.Type()
 
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
-		if tagStr := field.Tag.Get("query"); tagStr != "" {
-			tag := parseTag(tagStr)
-			val := fmt.Sprintf("%v", value.Field(i))
-			if val == "" && tag.omitempty {
-				continue
+		tagStr := field.Tag.Get("query")
+
+		if tagStr == "" {
+			if field.Anonymous && indirectType(field.Type).Kind() == reflect.Struct {
+				embedded := value.Field(i)
+				if embedded.Kind() == reflect.Pointer {
+					if embedded.IsNil() {
+						continue
+					}
+					embedded = embedded.Elem()
+				}
+				var err error
+				if params, err = appendQueryTags(params, embedded); err != nil {
+					return nil, err
+				}
 			}
-			params = append(params, Parameter{tag.tag: val})
+			continue
+		}
+		if tagStr == "-" || !field.IsExported() {
+			continue
+		}
+
+		tag := parseTag(tagStr)
+		val, isZero, err := encodeQueryValue(value.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: query parameter %q: %w", t.Name(), field.Name, tag.tag, err)
 		}
+		if isZero && tag.required {
+			return nil, fmt.Errorf("%s.%s: query parameter %q is required", t.Name(), field.Name, tag.tag)
+		}
+		if isZero && tag.omitempty {
+			continue
+		}
+		params = append(params, Parameter{tag.tag: val})
 	}
-	return params
+	return params, nil
+}
+
+// encodeQueryValue encode a single field, reporting whether it holds a zero value
+func encodeQueryValue(value reflect.Value) (string, bool, error) {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", true, nil
+		}
+		// a pointer to a zero value is still a value that has been set, e.g. a pointer to 0 or false
+		val, _, err := encodeQueryValue(value.Elem())
+		return val, false, err
+	}
+
+	if marshaler, ok := queryMarshaler(value); ok {
+		val, err := marshaler.MarshalQuery()
+		return val, val == "", err
+	}
+	if value.Type() == timeType {
+		if value.IsZero() {
+			return "", true, nil
+		}
+		return value.Interface().(time.Time).Format(time.RFC3339), false, nil
+	}
+	if value.Type().Implements(textMarshalerType) {
+		text, err := value.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), len(text) == 0, err
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), value.Len() == 0, nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), !value.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), value.Int() == 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), value.Uint() == 0, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits()), value.Float() == 0, nil
+	case reflect.Slice, reflect.Array:
+		values := make([]string, value.Len())
+		for i := range values {
+			val, _, err := encodeQueryValue(value.Index(i))
+			if err != nil {
+				return "", false, err
+			}
+			values[i] = val
+		}
+		return strings.Join(values, ","), value.Len() == 0, nil
+	}
+	return "", false, fmt.Errorf("unsupported type %s", value.Type())
+}
+
+// queryMarshaler returns the value as a QueryMarshaler, if either it or a pointer to it implements the interface
+func queryMarshaler(value reflect.Value) (QueryMarshaler, bool) {
+	if value.Type().Implements(queryMarshalerType) {
+		return value.Interface().(QueryMarshaler), true
+	}
+	if value.CanAddr() && reflect.PointerTo(value.Type()).Implements(queryMarshalerType) {
+		return value.Addr().Interface().(QueryMarshaler), true
+	}
+	return nil, false
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
 }
 
 func parseTag(tagStr string) tags {
@@ -58,7 +194,7 @@ func parseTag(tagStr string) tags {
 		} else if key == "omitempty" {
 			t.omitempty = true
 		} else if key == "required" {
-			t.omitempty = true
+			t.required = true
 		}
 	}
 	return t
@@ -1,11 +1,33 @@
 package miro
 
 import (
+	"errors"
 	. "github.com/smartystreets/goconvey/convey"
+	"strings"
 	"testing"
+	"time"
 )
 
-func TestParseQueryTags(t *testing.T) {
+// testSortOrder a custom query parameter type
+type testSortOrder struct {
+	field      string
+	descending bool
+}
+
+func (s *testSortOrder) MarshalQuery() (string, error) {
+	if s.field == "" {
+		return "", nil
+	}
+	if strings.Contains(s.field, ",") {
+		return "", errors.New("invalid field")
+	}
+	if s.descending {
+		return "-" + s.field, nil
+	}
+	return s.field, nil
+}
+
+func TestEncodeQueryTags(t *testing.T) {
 	Convey("Given a struct with query tags", t, func() {
 		type testStruct struct {
 			Foo string `query:"foo,omitempty"`
@@ -25,10 +47,20 @@ func TestParseQueryTags(t *testing.T) {
 			{"qux": ""},
 		}
 
-		Convey("When parseQueryTags is called", func() {
-			result := parseQueryTags(input)
+		Convey("When encodeQueryTags is called", func() {
+			result, err := encodeQueryTags(input)
+
+			Convey("The result should match the expected output", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldResemble, expected)
+			})
+		})
+
+		Convey("When encodeQueryTags is called with a pointer to the struct", func() {
+			result, err := encodeQueryTags(&input)
 
 			Convey("The result should match the expected output", func() {
+				So(err, ShouldBeNil)
 				So(result, ShouldResemble, expected)
 			})
 		})
@@ -47,12 +79,151 @@ func TestParseQueryTags(t *testing.T) {
 		}
 		expected := []Parameter{}
 
-		Convey("When parseQueryTags is called", func() {
-			result := parseQueryTags(input)
+		Convey("When encodeQueryTags is called", func() {
+			result, err := encodeQueryTags(input)
 
 			Convey("The result should be an empty slice", func() {
+				So(err, ShouldBeNil)
 				So(result, ShouldResemble, expected)
 			})
 		})
 	})
+
+	Convey("Given a struct with fields of every supported type", t, func() {
+		type Embedded struct {
+			Page uint `query:"page,omitempty"`
+		}
+		type testStruct struct {
+			Embedded
+			Limit    int           `query:"limit,omitempty"`
+			Width    int32         `query:"width,omitempty"`
+			Scale    float64       `query:"scale,omitempty"`
+			Archived bool          `query:"archived,omitempty"`
+			Shared   *bool         `query:"shared,omitempty"`
+			Since    time.Time     `query:"since,omitempty"`
+			Types    []ItemType    `query:"types,omitempty"`
+			Sort     testSortOrder `query:"sort,omitempty"`
+			Ignored  string        `query:"-"`
+		}
+
+		Convey("When encodeQueryTags is called with every field set", func() {
+			shared := false
+			result, err := encodeQueryTags(testStruct{
+				Embedded: Embedded{Page: 2},
+				Limit:    50,
+				Width:    640,
+				Scale:    1.5,
+				Archived: true,
+				Shared:   &shared,
+				Since:    time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+				Types:    []ItemType{ItemTypeCard, ItemTypeStickyNote},
+				Sort:     testSortOrder{field: "name", descending: true},
+				Ignored:  "ignored",
+			})
+
+			Convey("Then each field is encoded", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldResemble, []Parameter{
+					{"page": "2"},
+					{"limit": "50"},
+					{"width": "640"},
+					{"scale": "1.5"},
+					{"archived": "true"},
+					{"shared": "false"},
+					{"since": "2023-06-01T12:00:00Z"},
+					{"types": "card,sticky_note"},
+					{"sort": "-name"},
+				})
+			})
+		})
+
+		Convey("When encodeQueryTags is called with every field left empty", func() {
+			result, err := encodeQueryTags(testStruct{})
+
+			Convey("Then no zero values are sent", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a QueryMarshaler returns an error", func() {
+			_, err := encodeQueryTags(testStruct{Sort: testSortOrder{field: "a,b"}})
+
+			Convey("Then the error is returned with the field it came from", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "testStruct.Sort")
+				So(err.Error(), ShouldContainSubstring, "invalid field")
+			})
+		})
+	})
+
+	Convey("Given a struct with a required field", t, func() {
+		type testStruct struct {
+			TeamID string `query:"team_id,required"`
+			Limit  int    `query:"limit,omitempty"`
+		}
+
+		Convey("When encodeQueryTags is called without the required field", func() {
+			_, err := encodeQueryTags(testStruct{Limit: 10})
+
+			Convey("Then a descriptive error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, `testStruct.TeamID: query parameter "team_id" is required`)
+			})
+		})
+
+		Convey("When encodeQueryTags is called with the required field", func() {
+			result, err := encodeQueryTags(testStruct{TeamID: "gophers"})
+
+			Convey("Then the field is encoded", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldResemble, []Parameter{{"team_id": "gophers"}})
+			})
+		})
+	})
+
+	Convey("Given input that isn't a struct", t, func() {
+		Convey("When encodeQueryTags is called", func() {
+			_, err := encodeQueryTags("team_id=gophers")
+
+			Convey("Then an error is returned rather than a panic", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When encodeQueryTags is called with a nil pointer", func() {
+			result, err := encodeQueryTags((*BoardSearchParams)(nil))
+
+			Convey("Then no parameters are returned", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a struct with a field of an unsupported type", t, func() {
+		type testStruct struct {
+			Filter map[string]string `query:"filter"`
+		}
+
+		Convey("When encodeQueryTags is called", func() {
+			_, err := encodeQueryTags(testStruct{})
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "unsupported type")
+			})
+		})
+	})
+
+	Convey("Given OEmbedParams with only the format set", t, func() {
+		Convey("When encodeQueryTags is called", func() {
+			result, err := encodeQueryTags(OEmbedParams{Format: OEmbedFormatJSON})
+
+			Convey("Then the zero max width & height are not sent", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldResemble, []Parameter{{"format": "json"}})
+			})
+		})
+	})
 }
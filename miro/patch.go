@@ -0,0 +1,201 @@
+package miro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// PatchField a field of an Update payload that is sent even though it has its zero value, or that is sent as null.
+// Update methods only send the fields of the payload that are set, so that fields the caller didn't mean to touch
+// (e.g. an x coordinate of 0 or an empty fill colour) aren't reset. Fields are addressed by their JSON path, e.g.
+//
+//	client.StickyNotes.Update(boardID, itemID, miro.StickyNoteSet{}, miro.IncludeField("position.x"), miro.NullField("parent.id"))
+//
+// moves a sticky note to x=0 and detaches it from its parent frame.
+type PatchField struct {
+	path string
+	null bool
+}
+
+// IncludeField sends the field at the given JSON path even if it has its zero value
+func IncludeField(path string) PatchField {
+	return PatchField{path: path}
+}
+
+// NullField sends null for the field at the given JSON path, e.g. NullField("parent.id") to attach an item directly to
+// the canvas
+func NullField(path string) PatchField {
+	return PatchField{path: path, null: true}
+}
+
+// patch the body of a PATCH request: the payload without its zero values, except for the included fields, with the
+// null fields set to null
+type patch struct {
+	payload interface{}
+	fields  []PatchField
+}
+
+func newPatch(payload interface{}, fields []PatchField) patch {
+	return patch{payload: payload, fields: fields}
+}
+
+func (p patch) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(p.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	body := make(map[string]interface{})
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
+
+	included := make(map[string]bool)
+	for _, field := range p.fields {
+		if !field.null {
+			included[field.path] = true
+		}
+	}
+	pruneZeroValues(body, "", included)
+
+	for _, field := range p.fields {
+		path := strings.Split(field.path, ".")
+		fieldType, err := jsonPathType(reflect.TypeOf(p.payload), path)
+		if err != nil {
+			return nil, fmt.Errorf("%T: %w", p.payload, err)
+		}
+
+		if field.null {
+			setJSONPath(body, path, nil)
+		} else if !hasJSONPath(body, path) {
+			// the field was left out by omitempty, so send the zero value of its type
+			setJSONPath(body, path, reflect.Zero(fieldType).Interface())
+		}
+	}
+
+	return json.Marshal(body)
+}
+
+// pruneZeroValues remove the fields with zero values (including objects left empty once their own zero values are
+// removed) that aren't included
+func pruneZeroValues(object map[string]interface{}, prefix string, included map[string]bool) {
+	for key, value := range object {
+		path := prefix + key
+		if included[path] {
+			continue
+		}
+
+		if child, ok := value.(map[string]interface{}); ok {
+			pruneZeroValues(child, path+".", included)
+		}
+		if isZeroJSONValue(value) {
+			delete(object, key)
+		}
+	}
+}
+
+func isZeroJSONValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		f, err := strconv.ParseFloat(string(v), 64)
+		return err == nil && f == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func hasJSONPath(object map[string]interface{}, path []string) bool {
+	for i, key := range path {
+		value, ok := object[key]
+		if !ok {
+			return false
+		}
+		if i == len(path)-1 {
+			return true
+		}
+		if object, ok = value.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return false
+}
+
+// setJSONPath set the value at the path, creating (or replacing non-object values with) the objects along the way
+func setJSONPath(object map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			object[key] = child
+		}
+		object = child
+	}
+	object[path[len(path)-1]] = value
+}
+
+// jsonPathType the type of the struct field at the JSON path
+func jsonPathType(t reflect.Type, path []string) (reflect.Type, error) {
+	for i, name := range path {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("unknown field %q", strings.Join(path[:i+1], "."))
+		}
+
+		field, ok := jsonField(t, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", strings.Join(path[:i+1], "."))
+		}
+		t = field.Type
+	}
+	return t, nil
+}
+
+// jsonField the field of a struct type (or of the structs embedded in it) with the given JSON name
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		fieldName, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && fieldName == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if f, ok := jsonField(embedded, name); ok {
+					return f, true
+				}
+				continue
+			}
+		}
+
+		if fieldName == "" {
+			fieldName = field.Name
+		}
+		if field.IsExported() && fieldName == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,95 @@
+package miro
+
+import (
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestPatch(t *testing.T) {
+	Convey("Given an Update payload with only some fields set", t, func() {
+		payload := StickyNoteSet{
+			Data:     StickyNoteData{Content: "Updated"},
+			Position: PositionSet{X: 100},
+		}
+
+		Convey("When it is encoded as a patch", func() {
+			data, err := json.Marshal(newPatch(payload, nil))
+
+			Convey("Then only the fields that are set are sent", func() {
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, `{"data":{"content":"Updated"},"position":{"x":100}}`)
+			})
+		})
+
+		Convey("When fields with zero values are included", func() {
+			data, err := json.Marshal(newPatch(payload, []PatchField{IncludeField("position.y"), IncludeField("position.origin")}))
+
+			Convey("Then they are sent, even those left out by omitempty", func() {
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, `{"data":{"content":"Updated"},"position":{"origin":"","x":100,"y":0}}`)
+			})
+		})
+
+		Convey("When a whole object is included", func() {
+			data, err := json.Marshal(newPatch(payload, []PatchField{IncludeField("style")}))
+
+			Convey("Then the object is sent with all its fields", func() {
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, `{"data":{"content":"Updated"},"position":{"x":100},"style":{"fillColor":"","textAlign":"","textAlignVertical":""}}`)
+			})
+		})
+
+		Convey("When a field is nulled", func() {
+			data, err := json.Marshal(newPatch(payload, []PatchField{NullField("parent.id")}))
+
+			Convey("Then null is sent for the field", func() {
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, `{"data":{"content":"Updated"},"parent":{"id":null},"position":{"x":100}}`)
+			})
+		})
+
+		Convey("When a field that doesn't exist is included", func() {
+			_, err := json.Marshal(newPatch(payload, []PatchField{IncludeField("position.z")}))
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `miro.StickyNoteSet: unknown field "position.z"`)
+			})
+		})
+	})
+}
+
+func TestUpdateSendsPatch(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+
+	var body string
+	mux.HandleFunc(testResourcePath+"/"+testItemID, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write([]byte(`{"id": "` + testItemID + `"}`))
+	})
+
+	Convey("Given an item within a frame", t, func() {
+		Convey("When the item is moved to the canvas without changing its position", func() {
+			_, err := client.Items.Update(testBoardID, testItemID, ItemUpdate{}, NullField("parent.id"))
+
+			Convey("Then only the parent is sent", func() {
+				So(err, ShouldBeNil)
+				So(body, ShouldEqual, `{"parent":{"id":null}}`)
+			})
+		})
+
+		Convey("When the item is moved to x=0", func() {
+			_, err := client.Items.Update(testBoardID, testItemID, ItemUpdate{Position: PositionSet{Y: 50}}, IncludeField("position.x"))
+
+			Convey("Then the parent isn't reset", func() {
+				So(err, ShouldBeNil)
+				So(body, ShouldEqual, `{"position":{"x":0,"y":50}}`)
+			})
+		})
+	})
+}
@@ -0,0 +1,135 @@
+package miro
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// defaultFetchConcurrency the number of pages fetched at once when FetchOptions.Concurrency isn't set
+const defaultFetchConcurrency = 4
+
+// ErrRequestBudgetExceeded returned along with the results fetched so far when fetching every page would take more
+// requests than FetchOptions.MaxRequests allows
+var ErrRequestBudgetExceeded = errors.New("fetching every page would exceed the request budget")
+
+// FetchOptions controls the concurrent fetching of the pages of an offset-paginated list
+type FetchOptions struct {
+	// Concurrency the maximum number of pages fetched at once. Default: 4
+	Concurrency int
+	// MaxRequests the maximum number of requests made, including the one for the first page. Zero means no limit.
+	MaxRequests int
+}
+
+// fetchOffsetPages fetch the first page of an offset-paginated list, then fetch the rest of the pages concurrently, as
+// their offsets are known from the first page's total & limit. The items are returned in order, with any item that
+// shifted from one page to the next (and so was returned twice) only returned once.
+func fetchOffsetPages[T any](ctx context.Context, options FetchOptions, searchParams Parameter,
+	fetch func(ctx context.Context, params Parameter) ([]T, offsetPage, error), id func(item T) string) ([]T, error) {
+	first, page, err := fetch(ctx, searchParams)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, _ := strconv.Atoi(searchParams["limit"])
+	if limit <= 0 {
+		limit = page.limit
+	}
+	if limit <= 0 {
+		limit = len(first)
+	}
+
+	var offsets []int
+	if limit > 0 {
+		for offset := page.offset + limit; offset < page.total; offset += limit {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	var budgetErr error
+	if options.MaxRequests > 0 && len(offsets) > options.MaxRequests-1 {
+		offsets = offsets[:nonNegativeInt(options.MaxRequests-1)]
+		budgetErr = ErrRequestBudgetExceeded
+	}
+
+	pages := make([][]T, len(offsets))
+	if err := fetchConcurrently(ctx, options.Concurrency, len(offsets), func(ctx context.Context, i int) error {
+		params := make(Parameter, len(searchParams)+2)
+		for key, value := range searchParams {
+			params[key] = value
+		}
+		params["offset"] = strconv.Itoa(offsets[i])
+		params["limit"] = strconv.Itoa(limit)
+
+		items, _, err := fetch(ctx, params)
+		pages[i] = items
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var items []T
+	for _, pageItems := range append([][]T{first}, pages...) {
+		for _, item := range pageItems {
+			if key := id(item); !seen[key] {
+				seen[key] = true
+				items = append(items, item)
+			}
+		}
+	}
+	return items, budgetErr
+}
+
+// fetchConcurrently call fetch for each of the n pages, with at most concurrency calls at once. The first error
+// cancels the remaining calls and is returned.
+func fetchConcurrently(ctx context.Context, concurrency, n int, fetch func(ctx context.Context, i int) error) error {
+	if concurrency <= 0 {
+		concurrency = defaultFetchConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	semaphore := make(chan struct{}, concurrency)
+
+	for i := 0; i < n; i++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := fetch(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+func nonNegativeInt(i int) int {
+	if i < 0 {
+		return 0
+	}
+	return i
+}
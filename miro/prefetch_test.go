@@ -0,0 +1,110 @@
+package miro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBoardsFetchAll(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, "", "")
+	defer closeAPIServer()
+
+	var mu sync.Mutex
+	var requests, inFlight, maxInFlight int
+	// shift moves the boards after the first page back by one, as if a board had been added to the first page
+	var shift bool
+	var failOffset string
+
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		// give the other requests time to start
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Query().Get("offset") == failOffset {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		response := ListBoards{Offset: offset, Limit: limit, Total: 45}
+		start := offset
+		if shift && offset > 0 {
+			start--
+		}
+		for i := start; i < start+limit && i < 45; i++ {
+			response.Data = append(response.Data, &Board{ID: fmt.Sprintf("board-%02d", i)})
+		}
+		response.Size = len(response.Data)
+		json.NewEncoder(w).Encode(response)
+	})
+
+	Convey("Given 45 boards, returned 10 per page", t, func() {
+		requests, maxInFlight, shift, failOffset = 0, 0, false, "none"
+
+		Convey("When all the boards are fetched with a concurrency of 2", func() {
+			boards, err := client.Boards.FetchAll(FetchOptions{Concurrency: 2}, BoardSearchParams{TeamID: "gophers", Limit: "10"})
+
+			Convey("Then every board is returned in order, with no more than 2 pages fetched at once", func() {
+				So(err, ShouldBeNil)
+				So(boards, ShouldHaveLength, 45)
+				for i, board := range boards {
+					So(board.ID, ShouldEqual, fmt.Sprintf("board-%02d", i))
+				}
+				So(requests, ShouldEqual, 5)
+				So(maxInFlight, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the boards shift between pages while they are fetched", func() {
+			shift = true
+			boards, err := client.Boards.FetchAll(FetchOptions{}, BoardSearchParams{Limit: "10"})
+
+			Convey("Then the boards returned twice are only returned once", func() {
+				So(err, ShouldBeNil)
+				So(boards, ShouldHaveLength, 45)
+				So(boards[10].ID, ShouldEqual, "board-10")
+			})
+		})
+
+		Convey("When fetching every page would exceed the request budget", func() {
+			boards, err := client.Boards.FetchAll(FetchOptions{MaxRequests: 3}, BoardSearchParams{Limit: "10"})
+
+			Convey("Then the boards fetched within the budget are returned with an error", func() {
+				So(errors.Is(err, ErrRequestBudgetExceeded), ShouldBeTrue)
+				So(boards, ShouldHaveLength, 30)
+				So(requests, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When one of the pages fails", func() {
+			failOffset = "20"
+			boards, err := client.Boards.FetchAll(FetchOptions{}, BoardSearchParams{Limit: "10"})
+
+			Convey("Then the error is returned", func() {
+				So(IsRetryable(err), ShouldBeTrue)
+				So(boards, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,157 @@
+package miro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultCreditsPerMinute the number of credits MIRO allows per user per app each minute.
+const DefaultCreditsPerMinute = 100000
+
+// RateLimitLevel the rate limit level of an API method, as documented on each of the service methods.
+type RateLimitLevel int
+
+const (
+	RateLimitLevel1 RateLimitLevel = iota + 1
+	RateLimitLevel2
+	RateLimitLevel3
+	RateLimitLevel4
+)
+
+// Credits the number of credits a call to a method with this rate limit level costs.
+func (l RateLimitLevel) Credits() int {
+	switch l {
+	case RateLimitLevel1:
+		return 50
+	case RateLimitLevel2:
+		return 100
+	case RateLimitLevel3:
+		return 500
+	case RateLimitLevel4:
+		return 2000
+	default:
+		return 0
+	}
+}
+
+var ErrCreditBudgetExceeded = errors.New("credit budget exceeded")
+
+// CreditLimiter a client-side credit bucket modelled on MIRO's rate limiting. The bucket refills continuously at the
+// configured number of credits per minute. A single CreditLimiter can be shared between goroutines and clients that use
+// the same token, so they stay within the same budget.
+type CreditLimiter struct {
+	mu        sync.Mutex
+	perMinute float64
+	available float64
+	updated   time.Time
+	failFast  bool
+	now       func() time.Time
+}
+
+// NewCreditLimiter creates a full credit bucket with a budget of creditsPerMinute. When the budget would be exceeded,
+// requests block until enough credits are available, unless failFast is set, in which case ErrCreditBudgetExceeded
+// is returned instead.
+func NewCreditLimiter(creditsPerMinute int, failFast bool) *CreditLimiter {
+	return &CreditLimiter{
+		perMinute: float64(creditsPerMinute),
+		available: float64(creditsPerMinute),
+		updated:   time.Now(),
+		failFast:  failFast,
+		now:       time.Now,
+	}
+}
+
+// Remaining the number of credits currently available
+func (l *CreditLimiter) Remaining() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	return int(l.available)
+}
+
+// Wait take the credits from the bucket, blocking until they are available or the context is done
+func (l *CreditLimiter) Wait(ctx context.Context, credits int) error {
+	if float64(credits) > l.perMinute {
+		return fmt.Errorf("%w: %d credits requested, budget is %d per minute", ErrCreditBudgetExceeded, credits, int(l.perMinute))
+	}
+
+	for {
+		wait, err := l.reserve(credits)
+		if err != nil || wait == 0 {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve take the credits if available, otherwise return how long it will take for them to become available
+func (l *CreditLimiter) reserve(credits int) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	if l.available >= float64(credits) {
+		l.available -= float64(credits)
+		return 0, nil
+	}
+	if l.failFast {
+		return 0, fmt.Errorf("%w: %d credits requested, %d remaining", ErrCreditBudgetExceeded, credits, int(l.available))
+	}
+
+	deficit := float64(credits) - l.available
+	wait := time.Duration(deficit / l.perMinute * float64(time.Minute))
+	if wait < time.Millisecond {
+		wait = time.Millisecond
+	}
+	return wait, nil
+}
+
+func (l *CreditLimiter) refill() {
+	now := l.now()
+	elapsed := now.Sub(l.updated)
+	l.updated = now
+	if elapsed <= 0 {
+		return
+	}
+
+	l.available += elapsed.Minutes() * l.perMinute
+	if l.available > l.perMinute {
+		l.available = l.perMinute
+	}
+}
+
+type rateLimitLevelKey struct{}
+
+// withRateLimitLevel tag the context with the rate limit level of the method making the request
+func withRateLimitLevel(ctx context.Context, level RateLimitLevel) context.Context {
+	return context.WithValue(ctx, rateLimitLevelKey{}, level)
+}
+
+// requestCredits the credits a request costs. Requests made by the service methods are tagged with their level,
+// requests made directly with the native functions fall back to a level based on the HTTP method.
+func requestCredits(req *http.Request) int {
+	if level, ok := req.Context().Value(rateLimitLevelKey{}).(RateLimitLevel); ok {
+		return level.Credits()
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		return RateLimitLevel1.Credits()
+	case http.MethodDelete:
+		return RateLimitLevel3.Credits()
+	default:
+		return RateLimitLevel2.Credits()
+	}
+}
@@ -0,0 +1,150 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimitLevelCredits(t *testing.T) {
+	Convey("Given the rate limit levels", t, func() {
+		Convey("Then each level costs the documented number of credits", func() {
+			So(RateLimitLevel1.Credits(), ShouldEqual, 50)
+			So(RateLimitLevel2.Credits(), ShouldEqual, 100)
+			So(RateLimitLevel3.Credits(), ShouldEqual, 500)
+			So(RateLimitLevel4.Credits(), ShouldEqual, 2000)
+		})
+	})
+}
+
+func TestCreditLimiter(t *testing.T) {
+	Convey("Given a fail fast credit limiter with a budget of 1000 credits per minute", t, func() {
+		now := time.Now()
+		limiter := NewCreditLimiter(1000, true)
+		limiter.now = func() time.Time { return now }
+		limiter.updated = now
+
+		Convey("When credits are taken concurrently", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					limiter.Wait(context.Background(), RateLimitLevel1.Credits())
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then the remaining budget is reduced by the credits taken", func() {
+				So(limiter.Remaining(), ShouldEqual, 500)
+			})
+		})
+
+		Convey("When more credits are requested than remaining", func() {
+			err := limiter.Wait(context.Background(), 600)
+			So(err, ShouldBeNil)
+			err = limiter.Wait(context.Background(), 500)
+
+			Convey("Then ErrCreditBudgetExceeded is returned", func() {
+				So(errors.Is(err, ErrCreditBudgetExceeded), ShouldBeTrue)
+				So(limiter.Remaining(), ShouldEqual, 400)
+			})
+		})
+
+		Convey("When time passes after the budget has been used", func() {
+			limiter.Wait(context.Background(), 1000)
+			now = now.Add(30 * time.Second)
+
+			Convey("Then the budget is refilled in proportion", func() {
+				So(limiter.Remaining(), ShouldEqual, 500)
+			})
+		})
+
+		Convey("When more credits are requested than the whole budget", func() {
+			err := limiter.Wait(context.Background(), RateLimitLevel4.Credits())
+
+			Convey("Then an error is returned", func() {
+				So(errors.Is(err, ErrCreditBudgetExceeded), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a blocking credit limiter with no credits remaining", t, func() {
+		limiter := NewCreditLimiter(60000, false)
+		limiter.Wait(context.Background(), 60000)
+
+		Convey("When credits are requested", func() {
+			start := time.Now()
+			err := limiter.Wait(context.Background(), 50)
+
+			Convey("Then the call blocks until the credits have been refilled", func() {
+				So(err, ShouldBeNil)
+				So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 40*time.Millisecond)
+			})
+		})
+
+		Convey("When the context is cancelled while waiting", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			err := limiter.Wait(ctx, 50000)
+
+			Convey("Then the context error is returned", func() {
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestClientWithCreditLimiter(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "")
+	defer closeAPIServer()
+
+	client.RateLimiter = NewCreditLimiter(DefaultCreditsPerMinute, true)
+	// freeze the clock, so no credits are refilled while the requests are in flight
+	now := time.Now()
+	client.RateLimiter.now = func() time.Time { return now }
+
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewEncoder(w).Encode(Board{ID: testBoardID})
+	})
+
+	Convey("Given a client with a credit limiter", t, func() {
+		Convey("When a level 1 method is called", func() {
+			before := client.RateLimiter.Remaining()
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the credits for a level 1 method are taken", func() {
+				So(err, ShouldBeNil)
+				So(before-client.RateLimiter.Remaining(), ShouldBeBetweenOrEqual, 49, 50)
+			})
+		})
+
+		Convey("When a level 3 method is called", func() {
+			before := client.RateLimiter.Remaining()
+			err := client.Boards.Delete(testBoardID)
+
+			Convey("Then the credits for a level 3 method are taken", func() {
+				So(err, ShouldBeNil)
+				So(before-client.RateLimiter.Remaining(), ShouldBeBetweenOrEqual, 499, 500)
+			})
+		})
+
+		Convey("When the budget has been used up", func() {
+			client.RateLimiter.Wait(context.Background(), client.RateLimiter.Remaining())
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the request is not sent and an error is returned", func() {
+				So(errors.Is(err, ErrCreditBudgetExceeded), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,92 @@
+package miro
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+)
+
+// Response metadata of a response from the MIRO API
+type Response struct {
+	// StatusCode HTTP status code of the response
+	StatusCode int
+	// Header the response headers
+	Header http.Header
+	// RequestID MIRO's ID of the request, useful when raising a support ticket
+	RequestID string
+	// RateLimit the rate limit details sent with the response
+	RateLimit RateLimit
+	// Latency the time taken to get the response, including any retries
+	Latency time.Duration
+}
+
+// RateLimit the rate limit details sent by the MIRO API with each response
+type RateLimit struct {
+	// Limit the number of credits allowed per minute
+	Limit int
+	// Remaining the number of credits remaining in the current window
+	Remaining int
+	// Reset the time at which the current window resets
+	Reset time.Time
+}
+
+// ResponseCallback a function called with the metadata of every response received by the client
+type ResponseCallback func(req *http.Request, resp *Response)
+
+// WithResponseCallback sets a callback that is called with the metadata of every response received by the client
+func WithResponseCallback(callback ResponseCallback) ClientOption {
+	return func(c *Client) {
+		c.responseCallback = callback
+	}
+}
+
+type responseCaptureKey struct{}
+
+// WithResponseCapture returns a context that captures the metadata of the response into resp. Pass the context to any
+// of the WithContext service methods, or to the native functions, e.g.
+//
+//	var resp miro.Response
+//	board, err := client.Boards.GetWithContext(miro.WithResponseCapture(ctx, &resp), boardID)
+//	fmt.Println(resp.RateLimit.Remaining)
+func WithResponseCapture(ctx context.Context, resp *Response) context.Context {
+	return context.WithValue(ctx, responseCaptureKey{}, resp)
+}
+
+// recordResponse pass the response metadata on to the response capture & callback, if any
+func (c *Client) recordResponse(req *http.Request, resp *http.Response, latency time.Duration) {
+	capture, _ := req.Context().Value(responseCaptureKey{}).(*Response)
+	if capture == nil && c.responseCallback == nil {
+		return
+	}
+
+	metadata := newResponse(resp, latency)
+	if capture != nil {
+		*capture = *metadata
+	}
+	if c.responseCallback != nil {
+		c.responseCallback(req, metadata)
+	}
+}
+
+func newResponse(resp *http.Response, latency time.Duration) *Response {
+	r := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		RequestID:  resp.Header.Get(headerRequestID),
+		Latency:    latency,
+	}
+
+	r.RateLimit.Limit, _ = strconv.Atoi(resp.Header.Get(headerRateLimitLimit))
+	r.RateLimit.Remaining, _ = strconv.Atoi(resp.Header.Get(headerRateLimitRemaining))
+	if reset, err := strconv.ParseInt(resp.Header.Get(headerRateLimitReset), 10, 64); err == nil {
+		r.RateLimit.Reset = time.Unix(reset, 0)
+	}
+
+	return r
+}
@@ -0,0 +1,102 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestResponseCapture(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "")
+	defer closeAPIServer()
+
+	reset := time.Now().Add(time.Minute).Unix()
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "req-42")
+		w.Header().Set(headerRateLimitLimit, "100000")
+		w.Header().Set(headerRateLimitRemaining, "99950")
+		w.Header().Set(headerRateLimitReset, strconv.FormatInt(reset, 10))
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			json.NewEncoder(w).Encode(Board{ID: testBoardID})
+		}
+	})
+
+	Convey("Given a context that captures the response metadata", t, func() {
+		var resp Response
+		ctx := WithResponseCapture(context.Background(), &resp)
+
+		Convey("When a service method is called with the context", func() {
+			_, err := client.Boards.GetWithContext(ctx, testBoardID)
+
+			Convey("Then the response metadata is captured", func() {
+				So(err, ShouldBeNil)
+				So(resp.StatusCode, ShouldEqual, http.StatusOK)
+				So(resp.RequestID, ShouldEqual, "req-42")
+				So(resp.RateLimit, ShouldResemble, RateLimit{Limit: 100000, Remaining: 99950, Reset: time.Unix(reset, 0)})
+				So(resp.Header.Get(headerRequestID), ShouldEqual, "req-42")
+				So(resp.Latency, ShouldBeGreaterThan, 0)
+			})
+		})
+
+		Convey("When a native function is called with the context", func() {
+			err := client.Delete(ctx, client.BaseURL+testResourcePath)
+
+			Convey("Then the response metadata is captured", func() {
+				So(err, ShouldBeNil)
+				So(resp.StatusCode, ShouldEqual, http.StatusNoContent)
+				So(resp.RequestID, ShouldEqual, "req-42")
+			})
+		})
+
+		Convey("When the request fails", func() {
+			_, err := client.Boards.UpdateWithContext(ctx, testBoardID, SetBoard{})
+
+			Convey("Then the response metadata of the error response is captured", func() {
+				So(err, ShouldNotBeNil)
+				So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(resp.RateLimit.Remaining, ShouldEqual, 99950)
+			})
+		})
+	})
+}
+
+func TestResponseCallback(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "images")
+	defer closeAPIServer()
+
+	var received []*Response
+	var methods []string
+	WithResponseCallback(func(req *http.Request, resp *Response) {
+		methods = append(methods, req.Method)
+		received = append(received, resp)
+	})(client)
+
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "req-upload")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ImageItem{ID: testItemID})
+	})
+
+	Convey("Given a client with a response callback", t, func() {
+		Convey("When a multipart upload is made", func() {
+			_, err := client.Images.Upload(testBoardID, "./test_data/image_item_get.json", UploadFileItem{Title: "A test upload"})
+
+			Convey("Then the callback is called with the response metadata", func() {
+				So(err, ShouldBeNil)
+				So(len(received), ShouldEqual, 1)
+				So(methods[0], ShouldEqual, http.MethodPost)
+				So(received[0].StatusCode, ShouldEqual, http.StatusCreated)
+				So(received[0].RequestID, ShouldEqual, "req-upload")
+			})
+		})
+	})
+}
@@ -0,0 +1,180 @@
+package miro
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRetryAfter     = "Retry-After"
+	headerRateLimitReset = "X-RateLimit-Reset"
+)
+
+// RetryPolicy defines how the client retries requests that fail with a 429 (too many requests) or a 5xx status code.
+// Set Client.RetryPolicy to enable retries, leaving it nil disables them.
+type RetryPolicy struct {
+	// MaxAttempts The maximum number of attempts made for a single request, including the first one.
+	MaxAttempts int
+	// MinBackoff The backoff used before the first retry, doubled on every subsequent retry.
+	MinBackoff time.Duration
+	// MaxBackoff The upper limit of the calculated backoff. Waits requested by the server (via the Retry-After or
+	// X-RateLimit-Reset headers) are not capped.
+	MaxBackoff time.Duration
+	// RetryNonIdempotent Allow POST & PATCH requests to be retried as well. Retrying these requests may result in
+	// duplicate items being created, so only opt in if that is acceptable.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults: 4 attempts, with an exponential backoff starting
+// at 500ms and capped at 30s, and no retrying of POST & PATCH requests.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// do send the request, retrying it according to the client's retry policy (and once more if its token is rejected),
+// and record the response metadata
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := c.doWithAuth(req)
+	if resp != nil {
+		c.recordResponse(req, resp, time.Since(start))
+	}
+	return resp, err
+}
+
+func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxAttempts <= 1 || !policy.canRetry(req) {
+		return c.send(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(req)
+		if attempt >= policy.MaxAttempts || !retryable(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		wait := policy.backoff(attempt)
+		if resp != nil {
+			if serverWait, ok := retryAfter(resp.Header); ok {
+				wait = serverWait
+			}
+			drainAndClose(resp.Body)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// send authorize the request with the client's token source, if any, take the credits for the request from the
+// client's rate limiter, if any, and send the request through the middleware chain
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.tokenSource != nil {
+		if err := c.authorize(req); err != nil {
+			closeBody(req)
+			return nil, err
+		}
+	}
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(req.Context(), requestCredits(req)); err != nil {
+			closeBody(req)
+			return nil, err
+		}
+	}
+	return c.roundTrip()(req)
+}
+
+// closeBody close the body of a request that isn't sent, as the HTTP client would have done
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// canRetry check the request's method is allowed to be retried and that its body can be replayed
+func (p *RetryPolicy) canRetry(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPatch:
+		if !p.RetryNonIdempotent {
+			return false
+		}
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// backoff calculate an exponential backoff with full jitter for the given attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	ceiling := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if p.MaxBackoff > 0 && ceiling > float64(p.MaxBackoff) {
+		ceiling = float64(p.MaxBackoff)
+	}
+	if ceiling < 1 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling)))
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, ErrCreditBudgetExceeded) && !errors.Is(err, ErrTokenExpired)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter parse the wait time requested by the server. Retry-After can either be a number of seconds or a HTTP date,
+// X-RateLimit-Reset is the UTC epoch time in seconds at which the current rate limit window resets.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	if value := header.Get(headerRetryAfter); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(time.Until(date)), true
+		}
+	}
+	if value := header.Get(headerRateLimitReset); value != "" {
+		if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nonNegative(time.Until(time.Unix(epoch, 0))), true
+		}
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// drainAndClose read any remaining data from the body and close it, so the underlying connection can be reused
+func drainAndClose(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	io.Copy(io.Discard, body)
+	body.Close()
+}
@@ -0,0 +1,250 @@
+package miro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+}
+
+func TestRetryOnTooManyRequests(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "")
+	defer closeAPIServer()
+
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set(headerRetryAfter, "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(ResponseError{Status: http.StatusTooManyRequests, Message: "Too many requests"})
+			return
+		}
+		json.NewEncoder(w).Encode(Board{ID: testBoardID})
+	})
+
+	Convey("Given a client with a retry policy", t, func() {
+		Convey("When a GET request is rate limited twice", func() {
+			results, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the request is retried until it succeeds", func() {
+				So(err, ShouldBeNil)
+				So(results.ID, ShouldEqual, testBoardID)
+				So(attempts, ShouldEqual, 3)
+			})
+		})
+	})
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "")
+	defer closeAPIServer()
+
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(ResponseError{Status: http.StatusServiceUnavailable, Message: "Service unavailable"})
+	})
+
+	Convey("Given a client with a retry policy", t, func() {
+		Convey("When the server keeps failing with a 5xx status code", func() {
+			_, err := client.Boards.Get(testBoardID)
+
+			Convey("Then the last error is returned once the maximum attempts have been made", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "Service unavailable")
+				So(attempts, ShouldEqual, 3)
+			})
+		})
+	})
+}
+
+func TestRetryNonIdempotentRequests(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, "", "")
+	defer closeAPIServer()
+
+	var attempts int
+	var bodies []string
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Board{ID: testBoardID})
+	})
+
+	Convey("Given a client with a retry policy", t, func() {
+		Convey("When a POST request fails and retrying non-idempotent requests is disabled", func() {
+			attempts, bodies = 0, nil
+			client.RetryPolicy = testRetryPolicy()
+
+			_, err := client.Boards.Create(SetBoard{Name: testBoardName})
+
+			Convey("Then the request is not retried", func() {
+				So(err, ShouldNotBeNil)
+				So(attempts, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a POST request fails and retrying non-idempotent requests is enabled", func() {
+			attempts, bodies = 0, nil
+			client.RetryPolicy = testRetryPolicy()
+			client.RetryPolicy.RetryNonIdempotent = true
+
+			results, err := client.Boards.Create(SetBoard{Name: testBoardName})
+
+			Convey("Then the request is retried with the same body", func() {
+				So(err, ShouldBeNil)
+				So(results.ID, ShouldEqual, testBoardID)
+				So(attempts, ShouldEqual, 2)
+				So(bodies[1], ShouldEqual, bodies[0])
+				So(bodies[0], ShouldContainSubstring, testBoardName)
+			})
+		})
+	})
+}
+
+func TestRetryMultipartUpload(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "images")
+	defer closeAPIServer()
+
+	client.RetryPolicy = testRetryPolicy()
+	client.RetryPolicy.RetryNonIdempotent = true
+
+	attempts := 0
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		r.ParseMultipartForm(32 << 20)
+		file, _, err := r.FormFile("data")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		bodyData := UploadFileItem{}
+		json.NewDecoder(file).Decode(&bodyData)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ImageItem{Data: ImageItemData{Title: bodyData.Title}})
+	})
+
+	Convey("Given a client with a retry policy that retries non-idempotent requests", t, func() {
+		Convey("When a multipart upload fails on the first attempt", func() {
+			results, err := client.Images.Upload(testBoardID, "./test_data/image_item_get.json", UploadFileItem{Title: "A test upload"})
+
+			Convey("Then the multipart body is replayed on the retry", func() {
+				So(err, ShouldBeNil)
+				So(attempts, ShouldEqual, 2)
+				So(results.Data.Title, ShouldEqual, "A test upload")
+			})
+		})
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	Convey("Given response headers", t, func() {
+		Convey("When Retry-After is a number of seconds", func() {
+			wait, ok := retryAfter(http.Header{headerRetryAfter: []string{"7"}})
+
+			Convey("Then the wait time is returned", func() {
+				So(ok, ShouldBeTrue)
+				So(wait, ShouldEqual, 7*time.Second)
+			})
+		})
+
+		Convey("When Retry-After is a HTTP date", func() {
+			date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+			wait, ok := retryAfter(http.Header{headerRetryAfter: []string{date}})
+
+			Convey("Then the wait time until that date is returned", func() {
+				So(ok, ShouldBeTrue)
+				So(wait, ShouldBeBetweenOrEqual, 58*time.Second, time.Minute)
+			})
+		})
+
+		Convey("When only X-RateLimit-Reset is set", func() {
+			reset := strconv.FormatInt(time.Now().Add(10*time.Second).Unix(), 10)
+			header := http.Header{}
+			header.Set(headerRateLimitReset, reset)
+			wait, ok := retryAfter(header)
+
+			Convey("Then the wait time until the reset is returned", func() {
+				So(ok, ShouldBeTrue)
+				So(wait, ShouldBeBetweenOrEqual, 8*time.Second, 10*time.Second)
+			})
+		})
+
+		Convey("When no rate limit headers are set", func() {
+			_, ok := retryAfter(http.Header{})
+
+			Convey("Then no wait time is returned", func() {
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	Convey("Given a retry policy", t, func() {
+		policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+		for attempt := 1; attempt <= 6; attempt++ {
+			Convey(fmt.Sprintf("When the backoff is calculated for attempt %d", attempt), func() {
+				wait := policy.backoff(attempt)
+
+				Convey("Then it never exceeds the maximum backoff", func() {
+					So(wait, ShouldBeBetweenOrEqual, 0, time.Second)
+				})
+			})
+		}
+	})
+}
+
+func TestRetryPolicyCanRetry(t *testing.T) {
+	Convey("Given a retry policy", t, func() {
+		policy := DefaultRetryPolicy()
+
+		Convey("When the request has a body that cannot be replayed", func() {
+			req, _ := http.NewRequest(http.MethodPut, "http://no-where", io.NopCloser(bytes.NewBufferString("{}")))
+
+			Convey("Then the request is not retried", func() {
+				So(policy.canRetry(req), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the request has a body that can be replayed", func() {
+			req, _ := http.NewRequest(http.MethodPut, "http://no-where", bytes.NewBufferString("{}"))
+
+			Convey("Then the request can be retried", func() {
+				So(policy.canRetry(req), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type ShapeItemsService struct {
 	client      *Client
 	apiVersion  string
@@ -10,12 +12,17 @@ type ShapeItemsService struct {
 // Create a shape item on a board
 // Required scope: boards:write | Rate limiting: Level 2
 func (s *ShapeItemsService) Create(boardID string, payload SetShapeItem) (*ShapeItem, error) {
+	return s.CreateWithContext(s.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (s *ShapeItemsService) CreateWithContext(ctx context.Context, boardID string, payload SetShapeItem) (*ShapeItem, error) {
 	response := &ShapeItem{}
 
 	if url, err := constructURL(s.client.BaseURL, s.apiVersion, s.resource, boardID, s.subResource); err != nil {
 		return response, err
 	} else {
-		err = s.client.Post(s.client.ctx, url, payload, response)
+		err = s.client.Post(withAPIVersion(withRateLimitLevel(ctx, RateLimitLevel2), s.apiVersion), url, payload, response)
 		return response, err
 	}
 }
@@ -23,25 +30,35 @@ func (s *ShapeItemsService) Create(boardID string, payload SetShapeItem) (*Shape
 // Get information for a specific shape item on a board
 // Required scope: boards:read | Rate limiting: Level 1
 func (s *ShapeItemsService) Get(boardID, itemID string) (*ShapeItem, error) {
+	return s.GetWithContext(s.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (s *ShapeItemsService) GetWithContext(ctx context.Context, boardID, itemID string) (*ShapeItem, error) {
 	response := &ShapeItem{}
 
 	if url, err := constructURL(s.client.BaseURL, s.apiVersion, s.resource, boardID, s.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = s.client.Get(s.client.ctx, url, response)
+		err = s.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update a shape item on a board based on the data and style properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 2
-func (s *ShapeItemsService) Update(boardID, itemID string, payload SetShapeItem) (*ShapeItem, error) {
+func (s *ShapeItemsService) Update(boardID, itemID string, payload SetShapeItem, fields ...PatchField) (*ShapeItem, error) {
+	return s.UpdateWithContext(s.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (s *ShapeItemsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload SetShapeItem, fields ...PatchField) (*ShapeItem, error) {
 	response := &ShapeItem{}
 
 	if url, err := constructURL(s.client.BaseURL, s.apiVersion, s.resource, boardID, s.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = s.client.Patch(s.client.ctx, url, payload, response)
+		err = s.client.Patch(withAPIVersion(withRateLimitLevel(ctx, RateLimitLevel2), s.apiVersion), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -49,9 +66,14 @@ func (s *ShapeItemsService) Update(boardID, itemID string, payload SetShapeItem)
 // Delete a shape item from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (s *ShapeItemsService) Delete(boardID, itemID string) error {
+	return s.DeleteWithContext(s.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (s *ShapeItemsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(s.client.BaseURL, s.apiVersion, s.resource, boardID, s.subResource, itemID); err != nil {
 		return err
 	} else {
-		return s.client.Delete(s.client.ctx, url)
+		return s.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
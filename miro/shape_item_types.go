@@ -28,6 +28,35 @@ const (
 	ShapeRightBrace                 Shape = "right_brace"
 )
 
+// Flowchart shapes, which are only supported by the experimental version of the MIRO API, see WithAPIVersion. The data
+// shape of flowchart notation is ShapeFlowChartInputOutput.
+const (
+	ShapeFlowChartProcess            Shape = "flow_chart_process"
+	ShapeFlowChartDecision           Shape = "flow_chart_decision"
+	ShapeFlowChartTerminator         Shape = "flow_chart_terminator"
+	ShapeFlowChartDocument           Shape = "flow_chart_document"
+	ShapeFlowChartMultiDocuments     Shape = "flow_chart_multidocuments"
+	ShapeFlowChartInputOutput        Shape = "flow_chart_input_output"
+	ShapeFlowChartPredefinedProcess2 Shape = "flow_chart_predefined_process_2"
+	ShapeFlowChartPreparation        Shape = "flow_chart_preparation"
+	ShapeFlowChartManualInput        Shape = "flow_chart_manual_input"
+	ShapeFlowChartManualOperation    Shape = "flow_chart_manual_operation"
+	ShapeFlowChartConnector          Shape = "flow_chart_connector"
+	ShapeFlowChartOffpageConnector   Shape = "flow_chart_offpage_connector"
+	ShapeFlowChartDelay              Shape = "flow_chart_delay"
+	ShapeFlowChartDisplay            Shape = "flow_chart_display"
+	ShapeFlowChartMerge              Shape = "flow_chart_merge"
+	ShapeFlowChartOr                 Shape = "flow_chart_or"
+	ShapeFlowChartSummingJunction    Shape = "flow_chart_summing_junction"
+	ShapeFlowChartInternalStorage    Shape = "flow_chart_internal_storage"
+	ShapeFlowChartMagneticDisk       Shape = "flow_chart_magnetic_disk"
+	ShapeFlowChartMagneticDrum       Shape = "flow_chart_magnetic_drum"
+	ShapeFlowChartOnlineStorage      Shape = "flow_chart_online_storage"
+	ShapeFlowChartNoteCurlyLeft      Shape = "flow_chart_note_curly_left"
+	ShapeFlowChartNoteCurlyRight     Shape = "flow_chart_note_curly_right"
+	ShapeFlowChartNoteSquare         Shape = "flow_chart_note_square"
+)
+
 type ShapeItemData struct {
 	// Shape Defines the geometric shape of the item when it is rendered on the board.
 	Shape Shape `json:"shape"`
@@ -61,4 +90,15 @@ type ShapeItem struct {
 	Parent     *Parent         `json:"parent,omitempty"`
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
+	Extra      Extra           `json:"-"`
+}
+
+func (s *ShapeItem) UnmarshalJSON(data []byte) error {
+	type shapeItem ShapeItem
+	return unmarshalWithExtra(data, (*shapeItem)(s), &s.Extra)
+}
+
+func (s ShapeItem) MarshalJSON() ([]byte, error) {
+	type shapeItem ShapeItem
+	return marshalWithExtra(shapeItem(s), s.Extra)
 }
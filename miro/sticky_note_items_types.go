@@ -27,6 +27,17 @@ type StickyNote struct {
 	Parent     Parent          `json:"parent"`
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
+	Extra      Extra           `json:"-"`
+}
+
+func (s *StickyNote) UnmarshalJSON(data []byte) error {
+	type stickyNote StickyNote
+	return unmarshalWithExtra(data, (*stickyNote)(s), &s.Extra)
+}
+
+func (s StickyNote) MarshalJSON() ([]byte, error) {
+	type stickyNote StickyNote
+	return marshalWithExtra(stickyNote(s), s.Extra)
 }
 
 type NoteColor string
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type TagsService struct {
 	client      *Client
 	apiVersion  string
@@ -11,6 +13,11 @@ type TagsService struct {
 // Required scope: boards:read | Rate limiting: Level 1
 // Search query params: TagSearchParams{}
 func (t *TagsService) GetTags(boardID, tagID string, queryParams ...TagSearchParams) (*ListItems, error) {
+	return t.GetTagsWithContext(t.client.ctx, boardID, tagID, queryParams...)
+}
+
+// GetTagsWithContext GetTags using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) GetTagsWithContext(ctx context.Context, boardID, tagID string, queryParams ...TagSearchParams) (*ListItems, error) {
 	response := &ListItems{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, "items"); err != nil {
@@ -18,42 +25,114 @@ func (t *TagsService) GetTags(boardID, tagID string, queryParams ...TagSearchPar
 	} else {
 		var searchParams []Parameter
 		if len(queryParams) > 0 {
-			searchParams = parseQueryTags(queryParams[0])
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
 		}
 		searchParams = append(searchParams, Parameter{"tag_id": tagID})
 
-		err = t.client.Get(t.client.ctx, url, response, searchParams...)
+		err = t.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response, searchParams...)
 		return response, err
 	}
 }
 
-// Attach an existing tag to the specified item. Card and sticky note items can have up to 8 tags.
-// Required scope: boards:write | Rate limiting: Level 1
+// IterateTags returns an iterator over all the items with the specified tag, fetching the pages of results as they are
+// needed. The Offset of the search params is used as the starting point.
+// Required scope: boards:read | Rate limiting: Level 1 (per page)
+func (t *TagsService) IterateTags(boardID, tagID string, queryParams ...TagSearchParams) *Iterator[Item] {
+	return t.IterateTagsWithContext(t.client.ctx, boardID, tagID, queryParams...)
+}
+
+// IterateTagsWithContext IterateTags using the given context, which can be used to cancel the fetching of pages or set a
+// deadline.
+func (t *TagsService) IterateTagsWithContext(ctx context.Context, boardID, tagID string, queryParams ...TagSearchParams) *Iterator[Item] {
+	url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, "items")
+	var searchParams []Parameter
+	if err == nil && len(queryParams) > 0 {
+		searchParams, err = encodeQueryTags(queryParams[0])
+	}
+	searchParams = append(searchParams, Parameter{"tag_id": tagID})
+
+	return newOffsetIterator(ctx, t.client, RateLimitLevel1, url, err, searchParams, func(list *ListItems) ([]Item, offsetPage) {
+		return list.Data, offsetPage{links: &list.Links, offset: list.Offset, limit: list.Limit, size: list.Size, total: list.Total}
+	})
+}
+
+// maxItemTags the maximum number of tags a card or sticky note item can have
+const maxItemTags = 8
+
+// Attach an existing tag to the specified item. Card and sticky note items can have up to 8 tags, which is checked
+// before the tag is attached when the client validates payloads (see WithValidation), at the cost of fetching the
+// item's tags first.
+// Required scope: boards:write | Rate limiting: Level 1 (twice when validating)
 func (t *TagsService) Attach(boardID, itemID, tagID string) error {
+	return t.AttachWithContext(t.client.ctx, boardID, itemID, tagID)
+}
+
+// AttachWithContext Attach using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) AttachWithContext(ctx context.Context, boardID, itemID, tagID string) error {
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, "items", itemID); err != nil {
 		return err
 	} else {
-		return t.client.postNoContent(t.client.ctx, url, Parameter{"tag_id": tagID})
+		if t.client.validate {
+			if err := t.validateTagLimit(ctx, boardID, itemID, tagID); err != nil {
+				return err
+			}
+		}
+		return t.client.postNoContent(withRateLimitLevel(ctx, RateLimitLevel1), url, Parameter{"tag_id": tagID})
 	}
 }
 
+// validateTagLimit check the item can have another tag attached. The payloads of cards & sticky notes don't carry their
+// tags, so this is the only place the limit can be checked.
+func (t *TagsService) validateTagLimit(ctx context.Context, boardID, itemID, tagID string) error {
+	tags, err := t.GetTagsFromItemWithContext(ctx, boardID, itemID)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags.Tags {
+		if tag.ID == tagID {
+			// attaching a tag that is already attached doesn't add another
+			return nil
+		}
+	}
+
+	v := &validator{}
+	if len(tags.Tags) >= maxItemTags {
+		v.addf("tags", "can have at most %d tags, %s has %d already", maxItemTags, itemID, len(tags.Tags))
+	}
+	return v.result("Item")
+}
+
 // Detach removes the specified tag from the specified item. The tag still exists on the board.
 // Required scope: boards:write | Rate limiting: Level 1
 func (t *TagsService) Detach(boardID, itemID, tagID string) error {
+	return t.DetachWithContext(t.client.ctx, boardID, itemID, tagID)
+}
+
+// DetachWithContext Detach using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) DetachWithContext(ctx context.Context, boardID, itemID, tagID string) error {
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, "items", itemID); err != nil {
 		return err
 	} else {
-		return t.client.Delete(t.client.ctx, url, Parameter{"tag_id": tagID})
+		return t.client.Delete(withRateLimitLevel(ctx, RateLimitLevel1), url, Parameter{"tag_id": tagID})
 	}
 }
 
+// GetTagsFromItem retrieves all the tags from the specified item.
+// Required scope: boards:read | Rate limiting: Level 1
 func (t *TagsService) GetTagsFromItem(boardID, itemID string) (*ListTags, error) {
+	return t.GetTagsFromItemWithContext(t.client.ctx, boardID, itemID)
+}
+
+// GetTagsFromItemWithContext GetTagsFromItem using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) GetTagsFromItemWithContext(ctx context.Context, boardID, itemID string) (*ListTags, error) {
 	response := &ListTags{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, "items", itemID, "tags"); err != nil {
 		return response, err
 	} else {
-		err := t.client.Get(t.client.ctx, url, response)
+		err := t.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 
 		return response, err
 	}
@@ -62,54 +141,99 @@ func (t *TagsService) GetTagsFromItem(boardID, itemID string) (*ListTags, error)
 // Create a tag on a board.
 // Required scope: boards:write | Rate limiting: Level 1
 func (t *TagsService) Create(boardID string, payload TagSet) (*Tag, error) {
+	return t.CreateWithContext(t.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) CreateWithContext(ctx context.Context, boardID string, payload TagSet) (*Tag, error) {
 	response := &Tag{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource); err != nil {
 		return response, err
 	} else {
-		err = t.client.Post(t.client.ctx, url, payload, response)
+		err = t.client.Post(withRateLimitLevel(ctx, RateLimitLevel1), url, payload, response)
 		return response, err
 	}
 }
 
+// GetTagsFromBoard retrieves all the tags from the specified board.
+// Required scope: boards:read | Rate limiting: Level 1
+// Search query params: TagSearchParams{}
 func (t *TagsService) GetTagsFromBoard(boardID string, queryParams ...TagSearchParams) (*ListBoardTags, error) {
+	return t.GetTagsFromBoardWithContext(t.client.ctx, boardID, queryParams...)
+}
+
+// GetTagsFromBoardWithContext GetTagsFromBoard using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) GetTagsFromBoardWithContext(ctx context.Context, boardID string, queryParams ...TagSearchParams) (*ListBoardTags, error) {
 	response := &ListBoardTags{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, "tags"); err != nil {
 		return response, err
 	} else {
+		var searchParams []Parameter
 		if len(queryParams) > 0 {
-			err = t.client.Get(t.client.ctx, url, response, parseQueryTags(queryParams[0])...)
-		} else {
-			err = t.client.Get(t.client.ctx, url, response)
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
 		}
+		err = t.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response, searchParams...)
 
 		return response, err
 	}
 }
 
+// IterateTagsFromBoard returns an iterator over all the tags on a board, fetching the pages of results as they are needed.
+// Required scope: boards:read | Rate limiting: Level 1 (per page)
+func (t *TagsService) IterateTagsFromBoard(boardID string, queryParams ...TagSearchParams) *Iterator[Tag] {
+	return t.IterateTagsFromBoardWithContext(t.client.ctx, boardID, queryParams...)
+}
+
+// IterateTagsFromBoardWithContext IterateTagsFromBoard using the given context, which can be used to cancel the fetching
+// of pages or set a deadline.
+func (t *TagsService) IterateTagsFromBoardWithContext(ctx context.Context, boardID string, queryParams ...TagSearchParams) *Iterator[Tag] {
+	url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, "tags")
+	var searchParams []Parameter
+	if err == nil && len(queryParams) > 0 {
+		searchParams, err = encodeQueryTags(queryParams[0])
+	}
+
+	return newOffsetIterator(ctx, t.client, RateLimitLevel1, url, err, searchParams, func(list *ListBoardTags) ([]Tag, offsetPage) {
+		return list.Data, offsetPage{links: &list.Links, offset: list.Offset, limit: list.Limit, size: list.Size, total: list.Total}
+	})
+}
+
 // Get information for a specific tag.
 // Required scope: boards:read | Rate limiting: Level 1
 func (t *TagsService) Get(boardID, itemID string) (*Tag, error) {
+	return t.GetWithContext(t.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) GetWithContext(ctx context.Context, boardID, itemID string) (*Tag, error) {
 	response := &Tag{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = t.client.Get(t.client.ctx, url, response)
+		err = t.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update a tag based on the data properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 1
-func (t *TagsService) Update(boardID, itemID string, payload TagSet) (*Tag, error) {
+func (t *TagsService) Update(boardID, itemID string, payload TagSet, fields ...PatchField) (*Tag, error) {
+	return t.UpdateWithContext(t.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload TagSet, fields ...PatchField) (*Tag, error) {
 	response := &Tag{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = t.client.Patch(t.client.ctx, url, payload, response)
+		err = t.client.Patch(withRateLimitLevel(ctx, RateLimitLevel1), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -117,9 +241,14 @@ func (t *TagsService) Update(boardID, itemID string, payload TagSet) (*Tag, erro
 // Delete a specified tag from the board. The tag is also removed from all cards and sticky notes on the board.
 // Required scope: boards:write | Rate limiting: Level 1
 func (t *TagsService) Delete(boardID, itemID string) error {
+	return t.DeleteWithContext(t.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (t *TagsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource, itemID); err != nil {
 		return err
 	} else {
-		return t.client.Delete(t.client.ctx, url)
+		return t.client.Delete(withRateLimitLevel(ctx, RateLimitLevel1), url)
 	}
 }
@@ -2,6 +2,7 @@ package miro
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"net/http"
@@ -107,6 +108,60 @@ func TestAttachTag(t *testing.T) {
 	})
 }
 
+func TestAttachTagWithValidation(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+	WithValidation()(client)
+
+	var itemTags ListTags
+	attached := 0
+	mux.HandleFunc(fmt.Sprintf("%s/%s", testResourcePath, testItemID), func(w http.ResponseWriter, r *http.Request) {
+		attached++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/%s/tags", testResourcePath, testItemID), func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(itemTags)
+	})
+
+	Convey("Given a client that validates payloads and an item with 8 tags", t, func() {
+		attached = 0
+		itemTags = ListTags{}
+		for i := 0; i < maxItemTags; i++ {
+			itemTags.Tags = append(itemTags.Tags, Tag{ID: fmt.Sprintf("tag-%d", i)})
+		}
+
+		Convey("When another tag is attached", func() {
+			err := client.Tags.Attach(testBoardID, testItemID, testTagID)
+
+			Convey("Then a validation error is returned without attaching the tag", func() {
+				var validationErr *ValidationError
+				So(errors.As(err, &validationErr), ShouldBeTrue)
+				So(err.Error(), ShouldContainSubstring, "tags: can have at most 8 tags")
+				So(attached, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a tag that is already attached is attached again", func() {
+			err := client.Tags.Attach(testBoardID, testItemID, "tag-3")
+
+			Convey("Then the tag is attached", func() {
+				So(err, ShouldBeNil)
+				So(attached, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a tag is attached after one was detached", func() {
+			itemTags.Tags = itemTags.Tags[1:]
+			err := client.Tags.Attach(testBoardID, testItemID, testTagID)
+
+			Convey("Then the tag is attached", func() {
+				So(err, ShouldBeNil)
+				So(attached, ShouldEqual, 1)
+			})
+		})
+	})
+}
+
 func TestDetachTag(t *testing.T) {
 	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
 	defer closeAPIServer()
@@ -8,14 +8,36 @@ type Tag struct {
 	FillColor string `json:"fillColor"`
 	Links     Links  `json:"links"`
 	Type      string `json:"type"`
+	Extra     Extra  `json:"-"`
+}
+
+func (t *Tag) UnmarshalJSON(data []byte) error {
+	type tag Tag
+	return unmarshalWithExtra(data, (*tag)(t), &t.Extra)
+}
+
+func (t Tag) MarshalJSON() ([]byte, error) {
+	type tag Tag
+	return marshalWithExtra(tag(t), t.Extra)
 }
 
 type ListTags struct {
-	Tags []Tag `json:"tags"`
+	Tags  []Tag `json:"tags"`
+	Extra Extra `json:"-"`
+}
+
+func (l *ListTags) UnmarshalJSON(data []byte) error {
+	type listTags ListTags
+	return unmarshalWithExtra(data, (*listTags)(l), &l.Extra)
+}
+
+func (l ListTags) MarshalJSON() ([]byte, error) {
+	type listTags ListTags
+	return marshalWithExtra(listTags(l), l.Extra)
 }
 
 type TagSet struct {
-	// Title Text of the tag. Case-sensitive. Must be unique. (required)
+	// Title Text of the tag. Case-sensitive. Must be unique. Maximum 120 characters. (required)
 	Title string `json:"title"`
 	// FillColor Fill color for the tag.
 	FillColor TagColor `json:"fillColor"`
@@ -38,6 +60,17 @@ type ListBoardTags struct {
 	Limit  int             `json:"limit"`
 	Links  PaginationLinks `json:"links"`
 	Type   string          `json:"type"`
+	Extra  Extra           `json:"-"`
+}
+
+func (l *ListBoardTags) UnmarshalJSON(data []byte) error {
+	type listBoardTags ListBoardTags
+	return unmarshalWithExtra(data, (*listBoardTags)(l), &l.Extra)
+}
+
+func (l ListBoardTags) MarshalJSON() ([]byte, error) {
+	type listBoardTags ListBoardTags
+	return marshalWithExtra(listBoardTags(l), l.Extra)
 }
 
 const (
@@ -1,5 +1,7 @@
 package miro
 
+import "context"
+
 type TextItemsService struct {
 	client      *Client
 	apiVersion  string
@@ -10,12 +12,17 @@ type TextItemsService struct {
 // Create a text item on a board.
 // Required scope: boards:write | Rate limiting: Level 2
 func (t *TextItemsService) Create(boardID string, payload TextItemSet) (*TextItem, error) {
+	return t.CreateWithContext(t.client.ctx, boardID, payload)
+}
+
+// CreateWithContext Create using the given context, which can be used to cancel the request or set a deadline.
+func (t *TextItemsService) CreateWithContext(ctx context.Context, boardID string, payload TextItemSet) (*TextItem, error) {
 	response := &TextItem{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource); err != nil {
 		return response, err
 	} else {
-		err = t.client.Post(t.client.ctx, url, payload, response)
+		err = t.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
 		return response, err
 	}
 }
@@ -23,25 +30,35 @@ func (t *TextItemsService) Create(boardID string, payload TextItemSet) (*TextIte
 // Get information for a specific text item on a board
 // Required scope: boards:read | Rate limiting: Level 1
 func (t *TextItemsService) Get(boardID, itemID string) (*TextItem, error) {
+	return t.GetWithContext(t.client.ctx, boardID, itemID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (t *TextItemsService) GetWithContext(ctx context.Context, boardID, itemID string) (*TextItem, error) {
 	response := &TextItem{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = t.client.Get(t.client.ctx, url, response)
+		err = t.client.Get(withRateLimitLevel(ctx, RateLimitLevel1), url, response)
 		return response, err
 	}
 }
 
 // Update a text item on a board based on the data and style properties provided in the request body.
 // Required scope: boards:write | Rate limiting: Level 2
-func (t *TextItemsService) Update(boardID, itemID string, payload TextItemSet) (*TextItem, error) {
+func (t *TextItemsService) Update(boardID, itemID string, payload TextItemSet, fields ...PatchField) (*TextItem, error) {
+	return t.UpdateWithContext(t.client.ctx, boardID, itemID, payload, fields...)
+}
+
+// UpdateWithContext Update using the given context, which can be used to cancel the request or set a deadline.
+func (t *TextItemsService) UpdateWithContext(ctx context.Context, boardID, itemID string, payload TextItemSet, fields ...PatchField) (*TextItem, error) {
 	response := &TextItem{}
 
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource, itemID); err != nil {
 		return response, err
 	} else {
-		err = t.client.Patch(t.client.ctx, url, payload, response)
+		err = t.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
 		return response, err
 	}
 }
@@ -49,9 +66,14 @@ func (t *TextItemsService) Update(boardID, itemID string, payload TextItemSet) (
 // Delete a text item from the board.
 // Required scope: boards:write | Rate limiting: Level 3
 func (t *TextItemsService) Delete(boardID, itemID string) error {
+	return t.DeleteWithContext(t.client.ctx, boardID, itemID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (t *TextItemsService) DeleteWithContext(ctx context.Context, boardID, itemID string) error {
 	if url, err := constructURL(t.client.BaseURL, t.apiVersion, t.resource, boardID, t.subResource, itemID); err != nil {
 		return err
 	} else {
-		return t.client.Delete(t.client.ctx, url)
+		return t.client.Delete(withRateLimitLevel(ctx, RateLimitLevel3), url)
 	}
 }
@@ -19,6 +19,17 @@ type TextItem struct {
 	Parent     Parent          `json:"parent"`
 	Links      Links           `json:"links"`
 	Type       string          `json:"type"`
+	Extra      Extra           `json:"-"`
+}
+
+func (t *TextItem) UnmarshalJSON(data []byte) error {
+	type textItem TextItem
+	return unmarshalWithExtra(data, (*textItem)(t), &t.Extra)
+}
+
+func (t TextItem) MarshalJSON() ([]byte, error) {
+	type textItem TextItem
+	return marshalWithExtra(textItem(t), t.Extra)
 }
 
 type TextItemSet struct {
@@ -0,0 +1,191 @@
+package miro
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// TypedItem an item decoded into its concrete type: *AppCardItem, *CardItem, *DocumentItem, *EmbedItem, *FrameItem,
+// *ImageItem, *ShapeItem, *StickyNote or *TextItem, or *UnknownItem for a type this package doesn't support yet, e.g.
+//
+//	switch item := typed.(type) {
+//	case *miro.CardItem:
+//		fmt.Println(item.Data.DueDate)
+//	case *miro.StickyNote:
+//		fmt.Println(item.Data.Shape)
+//	}
+type TypedItem interface {
+	ItemID() string
+	ItemType() ItemType
+	ItemPosition() Position
+	ItemGeometry() Geometry
+	// ItemParent returns the item's parent, or nil if the item is directly on the canvas
+	ItemParent() *Parent
+}
+
+// UnknownItem an item of a type this package can't decode, along with the item's JSON
+type UnknownItem struct {
+	Item
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the fields of the Item, keeping the JSON in Raw
+func (u *UnknownItem) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &u.Item); err != nil {
+		return err
+	}
+	u.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON returns the item's JSON as it was received, or the JSON of the Item if there is none (e.g. for an
+// UnknownItem built by hand)
+func (u UnknownItem) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) == 0 {
+		return json.Marshal(u.Item)
+	}
+	return u.Raw, nil
+}
+
+var itemTypes = map[ItemType]func() TypedItem{
+	ItemTypeAppCard:    func() TypedItem { return &AppCardItem{} },
+	ItemTypeCard:       func() TypedItem { return &CardItem{} },
+	ItemTypeDocument:   func() TypedItem { return &DocumentItem{} },
+	ItemTypeEmbed:      func() TypedItem { return &EmbedItem{} },
+	ItemTypeFrame:      func() TypedItem { return &FrameItem{} },
+	ItemTypeImage:      func() TypedItem { return &ImageItem{} },
+	ItemTypeShape:      func() TypedItem { return &ShapeItem{} },
+	ItemTypeStickyNote: func() TypedItem { return &StickyNote{} },
+	ItemTypeText:       func() TypedItem { return &TextItem{} },
+}
+
+// DecodeItem decodes the JSON of an item into its concrete type, based on its type field
+func DecodeItem(data []byte) (TypedItem, error) {
+	var item Item
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+	return item.Decode()
+}
+
+// DecodeItems decodes each of the items into its concrete type, e.g. the items returned by Items.GetAll
+func DecodeItems(items []Item) ([]TypedItem, error) {
+	typed := make([]TypedItem, len(items))
+	for i := range items {
+		var err error
+		if typed[i], err = items[i].Decode(); err != nil {
+			return nil, err
+		}
+	}
+	return typed, nil
+}
+
+// Decode decodes each of the items in the list into its concrete type, e.g. the items in a group
+func (l *ListItems) Decode() ([]TypedItem, error) {
+	return DecodeItems(l.Data)
+}
+
+// Decode decodes the item into its concrete type, based on its type. Items returned by the MIRO API are decoded from the
+// JSON they were received as, so no fields are lost, unless their fields have been changed since, in which case they
+// are decoded from their current fields (and Extra).
+func (i *Item) Decode() (TypedItem, error) {
+	data := i.raw
+	if data == nil || i.changed() {
+		var err error
+		if data, err = json.Marshal(i); err != nil {
+			return nil, err
+		}
+	}
+
+	newItem, ok := itemTypes[ItemType(i.Type)]
+	if !ok {
+		return &UnknownItem{Item: *i, Raw: data}, nil
+	}
+
+	typed := newItem()
+	if err := json.Unmarshal(data, typed); err != nil {
+		return nil, fmt.Errorf("decoding %s item %s: %w", i.Type, i.ID, err)
+	}
+	return typed, nil
+}
+
+// changed whether the item's fields differ from those decoded from its JSON
+func (i *Item) changed() bool {
+	var decoded Item
+	if err := json.Unmarshal(i.raw, &decoded); err != nil {
+		return true
+	}
+	decoded.raw = nil
+	current := *i
+	current.raw = nil
+	return !reflect.DeepEqual(decoded, current)
+}
+
+func (i *Item) ItemID() string         { return i.ID }
+func (i *Item) ItemType() ItemType     { return ItemType(i.Type) }
+func (i *Item) ItemPosition() Position { return i.Position }
+func (i *Item) ItemGeometry() Geometry { return i.Geometry }
+func (i *Item) ItemParent() *Parent    { return i.Parent }
+
+func (a *AppCardItem) ItemID() string         { return a.ID }
+func (a *AppCardItem) ItemType() ItemType     { return ItemTypeAppCard }
+func (a *AppCardItem) ItemPosition() Position { return a.Position }
+func (a *AppCardItem) ItemGeometry() Geometry { return a.Geometry }
+func (a *AppCardItem) ItemParent() *Parent    { return a.Parent }
+
+func (c *CardItem) ItemID() string         { return c.ID }
+func (c *CardItem) ItemType() ItemType     { return ItemTypeCard }
+func (c *CardItem) ItemPosition() Position { return c.Position }
+func (c *CardItem) ItemGeometry() Geometry { return c.Geometry }
+func (c *CardItem) ItemParent() *Parent    { return c.Parent }
+
+func (d *DocumentItem) ItemID() string         { return d.ID }
+func (d *DocumentItem) ItemType() ItemType     { return ItemTypeDocument }
+func (d *DocumentItem) ItemPosition() Position { return d.Position }
+func (d *DocumentItem) ItemGeometry() Geometry { return d.Geometry }
+func (d *DocumentItem) ItemParent() *Parent    { return d.Parent }
+
+func (e *EmbedItem) ItemID() string         { return e.ID }
+func (e *EmbedItem) ItemType() ItemType     { return ItemTypeEmbed }
+func (e *EmbedItem) ItemPosition() Position { return e.Position }
+func (e *EmbedItem) ItemGeometry() Geometry { return e.Geometry }
+func (e *EmbedItem) ItemParent() *Parent    { return parentOrNil(e.Parent) }
+
+func (f *FrameItem) ItemID() string         { return f.ID }
+func (f *FrameItem) ItemType() ItemType     { return ItemTypeFrame }
+func (f *FrameItem) ItemPosition() Position { return f.Position }
+func (f *FrameItem) ItemGeometry() Geometry { return f.Geometry }
+func (f *FrameItem) ItemParent() *Parent    { return f.Parent }
+
+func (i *ImageItem) ItemID() string         { return i.ID }
+func (i *ImageItem) ItemType() ItemType     { return ItemTypeImage }
+func (i *ImageItem) ItemPosition() Position { return i.Position }
+func (i *ImageItem) ItemGeometry() Geometry { return i.Geometry }
+func (i *ImageItem) ItemParent() *Parent    { return i.Parent }
+
+func (s *ShapeItem) ItemID() string         { return s.ID }
+func (s *ShapeItem) ItemType() ItemType     { return ItemTypeShape }
+func (s *ShapeItem) ItemPosition() Position { return s.Position }
+func (s *ShapeItem) ItemGeometry() Geometry { return s.Geometry }
+func (s *ShapeItem) ItemParent() *Parent    { return s.Parent }
+
+func (s *StickyNote) ItemID() string         { return s.ID }
+func (s *StickyNote) ItemType() ItemType     { return ItemTypeStickyNote }
+func (s *StickyNote) ItemPosition() Position { return s.Position }
+func (s *StickyNote) ItemGeometry() Geometry { return s.Geometry }
+func (s *StickyNote) ItemParent() *Parent    { return parentOrNil(s.Parent) }
+
+func (t *TextItem) ItemID() string         { return t.ID }
+func (t *TextItem) ItemType() ItemType     { return ItemTypeText }
+func (t *TextItem) ItemPosition() Position { return t.Position }
+func (t *TextItem) ItemGeometry() Geometry { return t.Geometry }
+func (t *TextItem) ItemParent() *Parent    { return parentOrNil(t.Parent) }
+
+// parentOrNil returns nil for the empty parent of an item that is directly on the canvas
+func parentOrNil(parent Parent) *Parent {
+	if parent.ID == "" {
+		return nil
+	}
+	return &parent
+}
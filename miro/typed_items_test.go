@@ -0,0 +1,156 @@
+package miro
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDecodeItems(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "items")
+	defer closeAPIServer()
+
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": [
+			{"id": "1", "type": "card", "data": {"title": "Launch", "dueDate": "2024-03-01T00:00:00Z"}, "parent": {"id": "9"}},
+			{"id": "2", "type": "sticky_note", "data": {"content": "Idea", "shape": "rectangle"}, "position": {"x": 10, "y": 20}},
+			{"id": "3", "type": "embed", "data": {"html": "<iframe></iframe>", "mode": "inline"}},
+			{"id": "4", "type": "mindmap_node", "data": {"nodeView": {"type": "text"}}, "geometry": {"width": 100}}
+		]}`))
+	})
+
+	Convey("Given a board with items of several types", t, func() {
+		Convey("When the items returned by GetAll are decoded", func() {
+			list, err := client.Items.GetAll(testBoardID)
+			So(err, ShouldBeNil)
+			items, err := DecodeItems(list.Data)
+
+			Convey("Then each item is decoded into its concrete type, with the fields Item doesn't have", func() {
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 4)
+
+				card, ok := items[0].(*CardItem)
+				So(ok, ShouldBeTrue)
+				So(card.Data.DueDate, ShouldEqual, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+				So(card.ItemParent().ID, ShouldEqual, "9")
+
+				note, ok := items[1].(*StickyNote)
+				So(ok, ShouldBeTrue)
+				So(note.Data.NoteShape, ShouldEqual, NoteShapeRectangle)
+				So(note.ItemPosition().X, ShouldEqual, 10)
+				So(note.ItemParent(), ShouldBeNil)
+
+				embed, ok := items[2].(*EmbedItem)
+				So(ok, ShouldBeTrue)
+				So(embed.Data.Html, ShouldEqual, "<iframe></iframe>")
+			})
+
+			Convey("And an item of an unknown type falls back to its JSON", func() {
+				unknown, ok := items[3].(*UnknownItem)
+				So(ok, ShouldBeTrue)
+				So(unknown.ItemID(), ShouldEqual, "4")
+				So(unknown.ItemType(), ShouldEqual, ItemType("mindmap_node"))
+				So(unknown.ItemGeometry().Width, ShouldEqual, 100)
+
+				data, err := json.Marshal(unknown)
+				So(err, ShouldBeNil)
+				So(string(data), ShouldContainSubstring, `"nodeView":{"type":"text"}`)
+			})
+
+			Convey("And an unknown item without its JSON is marshalled from its fields, as a value too", func() {
+				data, err := json.Marshal(UnknownItem{Item: Item{ID: "5", Type: "mindmap_node"}})
+				So(err, ShouldBeNil)
+				So(string(data), ShouldContainSubstring, `"id":"5"`)
+
+				data, err = json.Marshal([]interface{}{*items[3].(*UnknownItem)})
+				So(err, ShouldBeNil)
+				So(string(data), ShouldContainSubstring, `"nodeView":{"type":"text"}`)
+			})
+		})
+	})
+
+	Convey("Given the JSON of each type of item", t, func() {
+		for _, test := range []struct {
+			file     string
+			expected TypedItem
+		}{
+			{file: "app_card_item_get.json", expected: &AppCardItem{}},
+			{file: "card_item_get.json", expected: &CardItem{}},
+			{file: "document_item_get.json", expected: &DocumentItem{}},
+			{file: "embed_item_get.json", expected: &EmbedItem{}},
+			{file: "frame_item_get.json", expected: &FrameItem{}},
+			{file: "image_item_get.json", expected: &ImageItem{}},
+			{file: "shape_item_get.json", expected: &ShapeItem{}},
+			{file: "sticky_note_item_get.json", expected: &StickyNote{}},
+			{file: "text_item_get.json", expected: &TextItem{}},
+		} {
+			Convey(fmt.Sprintf("When %s is decoded", test.file), func() {
+				data, err := os.ReadFile("./test_data/" + test.file)
+				So(err, ShouldBeNil)
+				So(json.Unmarshal(data, test.expected), ShouldBeNil)
+
+				item, err := DecodeItem(data)
+
+				Convey("Then it is decoded into the same type as the item's own endpoint returns", func() {
+					So(err, ShouldBeNil)
+					So(item, ShouldResemble, test.expected)
+					So(item.ItemType(), ShouldEqual, test.expected.ItemType())
+				})
+			})
+		}
+	})
+
+	Convey("Given an item decoded from JSON whose fields are changed", t, func() {
+		var item Item
+		So(json.Unmarshal([]byte(`{"id": "1", "type": "sticky_note", "data": {"content": "Idea", "shape": "square"}}`), &item), ShouldBeNil)
+		item.Type = "shape"
+		item.Data = ItemData{Shape: "circle", Content: "Idea"}
+
+		Convey("When it is decoded", func() {
+			typed, err := item.Decode()
+
+			Convey("Then it is decoded from its current fields rather than its JSON", func() {
+				So(err, ShouldBeNil)
+				shape, ok := typed.(*ShapeItem)
+				So(ok, ShouldBeTrue)
+				So(shape.Data.Shape, ShouldEqual, Shape("circle"))
+			})
+		})
+	})
+
+	Convey("Given the JSON of an item of an unknown type", t, func() {
+		data := []byte(`{"id": "4", "type": "mindmap_node", "data": {"nodeView": {"type": "text"}}}`)
+
+		Convey("When it is unmarshalled into an UnknownItem", func() {
+			var unknown UnknownItem
+			err := json.Unmarshal(data, &unknown)
+
+			Convey("Then both the Item and its JSON are kept", func() {
+				So(err, ShouldBeNil)
+				So(unknown.ItemID(), ShouldEqual, "4")
+				So(string(unknown.Raw), ShouldEqual, string(data))
+
+				marshalled, err := json.Marshal(unknown)
+				So(err, ShouldBeNil)
+				So(string(marshalled), ShouldContainSubstring, `"nodeView":{"type":"text"}`)
+			})
+		})
+	})
+
+	Convey("Given an item that wasn't decoded from JSON", t, func() {
+		item := Item{ID: "1", Type: "shape", Data: ItemData{Shape: "circle"}}
+
+		Convey("When it is decoded", func() {
+			typed, err := item.Decode()
+
+			Convey("Then the fields it has are carried over", func() {
+				So(err, ShouldBeNil)
+				So(typed.(*ShapeItem).Data.Shape, ShouldEqual, Shape("circle"))
+			})
+		})
+	})
+}
@@ -0,0 +1,238 @@
+package miro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path"
+)
+
+// sniffLen the number of bytes used to detect the content type of an upload
+const sniffLen = 512
+
+// ProgressFunc called as the file of an upload is sent, with the number of bytes sent so far and the total size of the
+// file, or -1 if the size is unknown
+type ProgressFunc func(sent, total int64)
+
+type uploadProgressKey struct{}
+
+// WithUploadProgress returns a context that reports the progress of uploads made with it to the progress function, e.g.
+//
+//	ctx := miro.WithUploadProgress(ctx, func(sent, total int64) { fmt.Printf("%d/%d\n", sent, total) })
+//	image, err := client.Images.UploadFromReaderWithContext(ctx, boardID, "diagram.png", reader, payload)
+func WithUploadProgress(ctx context.Context, progress ProgressFunc) context.Context {
+	return context.WithValue(ctx, uploadProgressKey{}, progress)
+}
+
+// newUploadParts build the multipart parts of a file upload: the file itself as the resource, and the item's details
+// as the data. The content type of the file is detected from its contents, falling back to its file extension. The
+// reader is only read once the payload has been checked (and the client's options), so nothing is read from it for
+// an upload that would be refused.
+func (c *Client) newUploadParts(ctx context.Context, fileName string, reader io.Reader, payload UploadFileItem) (MultiParts, error) {
+	if c.optionErr != nil {
+		return nil, c.optionErr
+	}
+	if c.validate {
+		if err := payload.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, reader, err := sniffContentType(fileName, reader)
+	if err != nil {
+		return nil, err
+	}
+	if progress, ok := ctx.Value(uploadProgressKey{}).(ProgressFunc); ok && progress != nil {
+		reader = newProgressReader(reader, progress)
+	}
+
+	return MultiParts{
+		"resource": {
+			Reader:      reader,
+			FileName:    fileName,
+			ContentType: contentType,
+		},
+		"data": {
+			Reader:      bytes.NewReader(data),
+			FileName:    fileName,
+			ContentType: "application/json",
+		},
+	}, nil
+}
+
+// sniffContentType detect the content type of the reader's contents. Seekable readers are rewound after sniffing,
+// otherwise the sniffed bytes are stitched back on to the front of the reader.
+func sniffContentType(fileName string, reader io.Reader) (string, io.Reader, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(reader, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	head = head[:n]
+
+	if seeker, ok := reader.(io.Seeker); ok {
+		if _, err := seeker.Seek(int64(-n), io.SeekCurrent); err != nil {
+			return "", nil, err
+		}
+	} else {
+		reader = io.MultiReader(bytes.NewReader(head), reader)
+	}
+
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" || contentType == "text/plain; charset=utf-8" {
+		if byExtension := mime.TypeByExtension(path.Ext(fileName)); byExtension != "" {
+			contentType = byExtension
+		}
+	}
+	return contentType, reader, nil
+}
+
+type progressReader struct {
+	reader   io.Reader
+	sent     int64
+	total    int64
+	progress ProgressFunc
+}
+
+// progressReadSeeker a progressReader that can be rewound, so uploads can be retried
+type progressReadSeeker struct {
+	*progressReader
+}
+
+func newProgressReader(reader io.Reader, progress ProgressFunc) io.Reader {
+	p := &progressReader{reader: reader, total: -1, progress: progress}
+
+	seeker, ok := reader.(io.Seeker)
+	if !ok {
+		return p
+	}
+	if current, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+		if end, err := seeker.Seek(0, io.SeekEnd); err == nil {
+			p.total = end - current
+		}
+		seeker.Seek(current, io.SeekStart)
+	}
+	return progressReadSeeker{p}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.progress(p.sent, p.total)
+	}
+	return n, err
+}
+
+func (p progressReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	seeker := p.reader.(io.Seeker)
+	current, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	position, err := seeker.Seek(offset, whence)
+	if err == nil {
+		p.sent += position - current
+	}
+	return position, err
+}
+
+// multipartBody streams a multipart form through a pipe, rather than holding the whole form in memory. When all the
+// parts' readers are seekable, the body can be replayed (e.g. to retry the request).
+type multipartBody struct {
+	parts    MultiParts
+	boundary string
+	offsets  map[string]int64
+	// reader & done the reading end of the current pipe and a channel closed once it has been written to
+	reader *io.PipeReader
+	done   chan struct{}
+}
+
+func newMultipartBody(parts MultiParts) *multipartBody {
+	m := &multipartBody{
+		parts:    parts,
+		boundary: multipart.NewWriter(nil).Boundary(),
+		offsets:  make(map[string]int64),
+	}
+
+	for key, value := range parts {
+		seeker, ok := value.Reader.(io.Seeker)
+		if !ok {
+			m.offsets = nil
+			break
+		}
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			m.offsets = nil
+			break
+		}
+		m.offsets[key] = offset
+	}
+
+	return m
+}
+
+func (m *multipartBody) contentType() string {
+	return "multipart/form-data; boundary=" + m.boundary
+}
+
+func (m *multipartBody) replayable() bool {
+	return m.offsets != nil
+}
+
+// open start writing the multipart form to a pipe, returning the reading end of the pipe
+func (m *multipartBody) open() io.ReadCloser {
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	m.reader, m.done = pr, done
+
+	go func() {
+		defer close(done)
+
+		writer := multipart.NewWriter(pw)
+		if err := writer.SetBoundary(m.boundary); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		for key, value := range m.parts {
+			part, err := createFormPart(key, value.FileName, value.ContentType, writer)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			if _, err = io.Copy(part, value.Reader); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+
+		// finalize the multipart request
+		pw.CloseWithError(writer.Close())
+	}()
+
+	return pr
+}
+
+// getBody stop writing the current form, then rewind the parts and open the multipart form again
+func (m *multipartBody) getBody() (io.ReadCloser, error) {
+	if m.done != nil {
+		m.reader.Close()
+		<-m.done
+	}
+	for key, offset := range m.offsets {
+		if _, err := m.parts[key].Reader.(io.Seeker).Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+	return m.open(), nil
+}
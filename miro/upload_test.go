@@ -0,0 +1,127 @@
+package miro
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+)
+
+func TestSniffContentType(t *testing.T) {
+	tests := []struct {
+		fileName    string
+		contents    string
+		contentType string
+	}{
+		{fileName: "gopher.png", contents: "\x89PNG\r\n\x1a\n", contentType: "image/png"},
+		{fileName: "plan.pdf", contents: "%PDF-1.7", contentType: "application/pdf"},
+		{fileName: "data.json", contents: `{"gopher": true}`, contentType: "application/json"},
+		{fileName: "unknown", contents: "\x00\x01\x02", contentType: "application/octet-stream"},
+	}
+
+	Convey("Given a file", t, func() {
+		for _, test := range tests {
+			Convey("When the content type of "+test.fileName+" is detected", func() {
+				contentType, reader, err := sniffContentType(test.fileName, io.MultiReader(strings.NewReader(test.contents)))
+
+				Convey("Then the expected content type is returned and the reader still holds the whole file", func() {
+					So(err, ShouldBeNil)
+					So(contentType, ShouldStartWith, test.contentType)
+					contents, _ := io.ReadAll(reader)
+					So(string(contents), ShouldEqual, test.contents)
+				})
+			})
+		}
+
+		Convey("When the reader is seekable", func() {
+			reader := strings.NewReader(strings.Repeat("a", 1024))
+			_, result, err := sniffContentType("a.txt", reader)
+
+			Convey("Then the reader is rewound", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldEqual, reader)
+				So(reader.Len(), ShouldEqual, 1024)
+			})
+		})
+	})
+}
+
+func TestMultipartBody(t *testing.T) {
+	Convey("Given multipart parts with seekable readers", t, func() {
+		parts := MultiParts{
+			"resource": {Reader: bytes.NewReader([]byte("file contents")), FileName: "file.txt", ContentType: "text/plain"},
+			"data":     {Reader: bytes.NewReader([]byte(`{"title":"test"}`)), FileName: "file.txt", ContentType: "application/json"},
+		}
+		body := newMultipartBody(parts)
+
+		Convey("When the body is read, then replayed", func() {
+			first, _ := io.ReadAll(body.open())
+			replay, err := body.getBody()
+			So(err, ShouldBeNil)
+			second, _ := io.ReadAll(replay)
+
+			Convey("Then both bodies hold the full form", func() {
+				So(body.replayable(), ShouldBeTrue)
+				for _, data := range [][]byte{first, second} {
+					_, params, _ := mime.ParseMediaType(body.contentType())
+					form, err := multipart.NewReader(bytes.NewReader(data), params["boundary"]).ReadForm(1 << 20)
+					So(err, ShouldBeNil)
+					So(form.File["resource"], ShouldHaveLength, 1)
+					So(form.File["data"], ShouldHaveLength, 1)
+				}
+			})
+		})
+
+		Convey("When the body is replayed before the first body has been read", func() {
+			body.open()
+			replay, err := body.getBody()
+			So(err, ShouldBeNil)
+			data, _ := io.ReadAll(replay)
+
+			Convey("Then the replayed body holds the full form", func() {
+				So(string(data), ShouldContainSubstring, "file contents")
+			})
+		})
+	})
+
+	Convey("Given multipart parts with a reader that can't be seeked", t, func() {
+		parts := MultiParts{
+			"resource": {Reader: io.MultiReader(strings.NewReader("file contents")), FileName: "file.txt", ContentType: "text/plain"},
+		}
+
+		Convey("When the body is created", func() {
+			body := newMultipartBody(parts)
+
+			Convey("Then it can't be replayed", func() {
+				So(body.replayable(), ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestProgressReader(t *testing.T) {
+	Convey("Given a seekable reader with a progress function", t, func() {
+		var sent, total int64
+		reader := newProgressReader(strings.NewReader(strings.Repeat("a", 100)), func(s, t int64) {
+			sent, total = s, t
+		})
+
+		Convey("When the reader is read", func() {
+			io.ReadAll(reader)
+
+			Convey("Then the progress is reported against the total size", func() {
+				So(sent, ShouldEqual, 100)
+				So(total, ShouldEqual, 100)
+
+				Convey("And rewinding the reader resets the progress", func() {
+					reader.(io.Seeker).Seek(0, io.SeekStart)
+					io.ReadFull(reader, make([]byte, 10))
+					So(sent, ShouldEqual, 10)
+				})
+			})
+		})
+	})
+}
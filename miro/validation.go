@@ -0,0 +1,531 @@
+package miro
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FieldError a field of a payload (or search params) that breaks one of the constraints documented by the MIRO API.
+// Field is the JSON (or query) name of the field, e.g. style.fontSize.
+type FieldError struct {
+	Field string
+	Msg   string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Msg)
+}
+
+// ValidationError every field of a payload (or search params) that breaks the documented constraints, returned by the
+// Validate methods
+type ValidationError struct {
+	// Type the name of the payload type, e.g. StickyNoteSet
+	Type   string
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Type, strings.Join(msgs, "; "))
+}
+
+// validatable payloads and search params with a Validate method
+type validatable interface {
+	Validate() error
+}
+
+// versionedValidatable payloads whose constraints depend on the version of the MIRO API they are sent to
+type versionedValidatable interface {
+	validateFor(version APIVersion) error
+}
+
+type apiVersionKey struct{}
+
+// withAPIVersion tag the context with the API version of the service making the request, for the payloads whose
+// constraints depend on it
+func withAPIVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, apiVersionKey{}, APIVersion(version))
+}
+
+// validatePayload validate the payload of a request (or the payload of a patch) if it has a Validate method, against
+// the constraints of the given API version if they depend on it and it is known
+func validatePayload(payload interface{}, version APIVersion) error {
+	if p, ok := payload.(patch); ok {
+		payload = p.payload
+	}
+	if v, ok := payload.(versionedValidatable); ok && version != "" {
+		return v.validateFor(version)
+	}
+	if v, ok := payload.(validatable); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// validator collects the field errors of a payload. Fields with zero values aren't checked, as they aren't sent (or
+// are left as they are by an update).
+type validator struct {
+	errors []FieldError
+}
+
+func (v *validator) addf(field, format string, args ...interface{}) {
+	v.errors = append(v.errors, FieldError{Field: field, Msg: fmt.Sprintf(format, args...)})
+}
+
+// result the ValidationError for the type, or nil if there are no field errors
+func (v *validator) result(typeName string) error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{Type: typeName, Errors: v.errors}
+}
+
+func (v *validator) hexColor(field, value string) {
+	if value != "" && !hexColor.MatchString(value) {
+		v.addf(field, "%q is not a hex colour, e.g. #ff0000", value)
+	}
+}
+
+func (v *validator) maxLength(field, value string, max int) {
+	if n := utf8.RuneCountInString(value); n > max {
+		v.addf(field, "must be at most %d characters, got %d", max, n)
+	}
+}
+
+// number check a number sent as a string is within the range, with an optional unit suffix (e.g. %)
+func (v *validator) number(field, value, unit string, min, max float64) {
+	if value == "" {
+		return
+	}
+	n, err := strconv.ParseFloat(strings.TrimSuffix(value, unit), 64)
+	if err != nil {
+		v.addf(field, "%q is not a number", value)
+		return
+	}
+	v.between(field, n, min, max)
+}
+
+func (v *validator) between(field string, n, min, max float64) {
+	if n < min || n > max {
+		v.addf(field, "must be between %s and %s, got %s", formatNumber(min), formatNumber(max), formatNumber(n))
+	}
+}
+
+func (v *validator) positive(field string, n float64) {
+	if n < 0 {
+		v.addf(field, "must not be negative, got %s", formatNumber(n))
+	}
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// oneOf check the value is one of the allowed values
+func oneOf[T ~string](v *validator, field string, value T, allowed ...T) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		names[i] = string(a)
+	}
+	v.addf(field, "%q is not one of %s", value, strings.Join(names, ", "))
+}
+
+var textAligns = []TextAlign{TextAlignLeft, TextAlignCenter, TextAlignRight}
+var textAlignsVertical = []TextAlignVertical{TextAlignVerticalTop, TextAlignVerticalMiddle, TextAlignVerticalBottom}
+
+func (v *validator) style(field string, style Style) {
+	v.hexColor(field+".borderColor", style.BorderColor)
+	v.number(field+".borderOpacity", style.BorderOpacity, "", 0, 1)
+	oneOf(v, field+".borderStyle", style.BorderStyle, BorderStyleNormal, BorderStyleDotted, BorderStyleDashed)
+	v.number(field+".borderWidth", style.BorderWidth, "", 1, 24)
+	v.hexColor(field+".color", style.Color)
+	v.hexColor(field+".fillColor", style.FillColor)
+	v.number(field+".fillOpacity", style.FillOpacity, "", 0, 1)
+	v.number(field+".fontSize", style.FontSize, "", 10, 288)
+	oneOf(v, field+".textAlign", style.TextAlign, textAligns...)
+	oneOf(v, field+".textAlignVertical", style.TextAlignVertical, textAlignsVertical...)
+}
+
+func (v *validator) position(field string, position PositionSet) {
+	oneOf(v, field+".origin", position.Origin, Center)
+}
+
+func (v *validator) geometry(field string, width, height float64) {
+	v.positive(field+".width", width)
+	v.positive(field+".height", height)
+}
+
+func (v *validator) callbackURL(field, value string) {
+	if value != "" && !strings.HasPrefix(value, "https://") {
+		v.addf(field, "must be an HTTPS URL")
+	}
+}
+
+// limit check a limit & offset sent as strings are within the range allowed by the endpoint
+func (v *validator) limit(limit, offset string, min, max float64) {
+	v.number("limit", limit, "", min, max)
+	if offset != "" {
+		if n, err := strconv.Atoi(offset); err != nil {
+			v.addf("offset", "%q is not a whole number", offset)
+		} else {
+			v.positive("offset", float64(n))
+		}
+	}
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API. Required fields aren't
+// checked, as the same payload is used to update a board.
+func (s SetBoard) Validate() error {
+	v := &validator{}
+	v.maxLength("name", s.Name, 60)
+	v.maxLength("description", s.Description, 300)
+
+	accesses := []Access{AccessPrivate, AccessView, AccessEdit, AccessComment}
+	sharing := s.Policy.SharingPolicy
+	oneOf(v, "policy.sharingPolicy.access", sharing.Access, accesses...)
+	oneOf(v, "policy.sharingPolicy.organizationAccess", sharing.OrganizationAccess, accesses...)
+	oneOf(v, "policy.sharingPolicy.teamAccess", sharing.TeamAccess, accesses...)
+	oneOf(v, "policy.sharingPolicy.inviteToAccountAndBoardLinkAccess", sharing.InviteToAccountAndBoardLinkAccess,
+		InviteAccessNoAccess, InviteAccessViewer, InviteAccessCommenter, InviteAccessEditor)
+
+	permissions := s.Policy.PermissionsPolicy
+	oneOf(v, "policy.permissionsPolicy.collaborationToolsStartAccess", permissions.CollaborationToolsStartAccess,
+		CollabAccessAllEditors, CollabAccessBoardOwnersAndCoOwners)
+	oneOf(v, "policy.permissionsPolicy.copyAccess", permissions.CopyAccess,
+		CopyAccessAnyone, CopyAccessTeamMembers, CopyAccessTeamEditors, CopyAccessBoardOwner)
+	oneOf(v, "policy.permissionsPolicy.sharingAccess", permissions.SharingAccess,
+		SharingAccessTeamMemberWithEditingRights, SharingAccessOwnersAndCoOwners)
+	return v.result("SetBoard")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p BoardSearchParams) Validate() error {
+	v := &validator{}
+	v.limit(p.Limit, p.Offset, 1, 50)
+	oneOf(v, "sort", p.Sort, SortDefault, SortLastModified, SortLastOpened, SortLastCreated, SortAlphabetically)
+	return v.result("BoardSearchParams")
+}
+
+// Validate checks the invitation against the constraints documented by the MIRO API
+func (s ShareBoardInvitation) Validate() error {
+	v := &validator{}
+	if len(s.Emails) == 0 {
+		v.addf("emails", "at least one email is required")
+	} else if len(s.Emails) > 20 {
+		v.addf("emails", "at most 20 members can be invited at once, got %d", len(s.Emails))
+	}
+	oneOf(v, "role", s.Role, RoleViewer, RoleCommenter, RoleEditor, RoleCoOwner, RoleOwner, RoleGuest)
+	return v.result("ShareBoardInvitation")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p BoardMemberSearchParams) Validate() error {
+	v := &validator{}
+	v.limit(p.Limit, p.Offset, 1, 50)
+	return v.result("BoardMemberSearchParams")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p ItemSearchParams) Validate() error {
+	v := &validator{}
+	v.number("limit", p.Limit, "", 10, 50)
+	oneOf(v, "type", p.Type, ItemTypeAppCard, ItemTypeCard, ItemTypeDocument, ItemTypeEmbed, ItemTypeFrame,
+		ItemTypeImage, ItemTypeShape, ItemTypeStickyNote, ItemTypeText)
+	return v.result("ItemSearchParams")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (i ItemUpdate) Validate() error {
+	v := &validator{}
+	v.position("position", i.Position)
+	return v.result("ItemUpdate")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (a AppCardItemSet) Validate() error {
+	v := &validator{}
+	oneOf(v, "data.status", a.Data.Status, StatusConnected, StatusDisconnected, StatusDisabled)
+	for i, field := range a.Data.Fields {
+		v.hexColor(fmt.Sprintf("data.fields[%d].fillColor", i), field.FillColor)
+		v.hexColor(fmt.Sprintf("data.fields[%d].textColor", i), field.TextColor)
+		if field.IconUrl != "" && !strings.HasPrefix(field.IconUrl, "https://") {
+			v.addf(fmt.Sprintf("data.fields[%d].iconUrl", i), "must be an HTTPS URL")
+		}
+	}
+	v.style("style", a.Style)
+	v.position("position", a.Position)
+	v.geometry("geometry", a.Geometry.Width, a.Geometry.Height)
+	return v.result("AppCardItemSet")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API. The limit of 8 tags
+// per item isn't checked, as the payload doesn't carry tags; it is checked by TagsService.Attach instead.
+func (c SetCardItem) Validate() error {
+	v := &validator{}
+	v.hexColor("style.cardTheme", c.Style.CardTheme)
+	v.position("position", c.Position)
+	v.geometry("geometry", c.Geometry.Width, c.Geometry.Height)
+	return v.result("SetCardItem")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p ConnectorSearchParams) Validate() error {
+	v := &validator{}
+	v.number("limit", p.Limit, "", 10, 50)
+	return v.result("ConnectorSearchParams")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (c SetConnector) Validate() error {
+	v := &validator{}
+	if c.StartItem.ID != "" && c.StartItem.ID == c.EndItem.ID {
+		v.addf("endItem.id", "must be different from startItem.id")
+	}
+	for _, end := range []struct {
+		field string
+		item  SetConnectorItem
+	}{{"startItem", c.StartItem}, {"endItem", c.EndItem}} {
+		field, item := end.field, end.item
+		v.number(field+".position.x", item.Position.X, "%", 0, 100)
+		v.number(field+".position.y", item.Position.Y, "%", 0, 100)
+		oneOf(v, field+".snapTo", item.SnapTo, SnapToAuto, SnapToTop, SnapToRight, SnapToLeft, SnapToBottom)
+		if item.SnapTo != "" && (item.Position.X != "" || item.Position.Y != "") {
+			v.addf(field, "only one of position and snapTo can be set")
+		}
+	}
+	for i, caption := range c.Captions {
+		v.number(fmt.Sprintf("captions[%d].position", i), caption.Position, "%", 0, 100)
+		oneOf(v, fmt.Sprintf("captions[%d].textAlignVertical", i), caption.TextAlignVertical, textAlignsVertical...)
+	}
+
+	strokeCaps := []StrokeCap{StrokeCapStealth, StrokeCapDiamond, StrokeCapDiamondFilled, StrokeCapOval,
+		StrokeCapOvalFilled, StrokeCapArrow, StrokeCapTriangle, StrokeCapTriangleFilled, StrokeCapErdOne,
+		StrokeCapErdMany, StrokeCapErdOnlyOne, StrokeCapErdZeroOrOne, StrokeCapErdOneOrMany}
+	v.hexColor("style.color", c.Style.Color)
+	oneOf(v, "style.startStrokeCap", c.Style.StartStrokeCap, strokeCaps...)
+	oneOf(v, "style.endStrokeCap", c.Style.EndStrokeCap, strokeCaps...)
+	v.number("style.fontSize", c.Style.FontSize, "", 10, 288)
+	v.hexColor("style.strokeColor", c.Style.StrokeColor)
+	oneOf(v, "style.strokeStyle", c.Style.StrokeStyle, StrokeStyleNormal, StrokeStyleDotted, StrokeStyleDashed)
+	v.number("style.strokeWidth", c.Style.StrokeWidth, "", 1, 24)
+	oneOf(v, "style.textOrientation", c.Style.TextOrientation, TextOrientationHorizontal, TextOrientationAligned)
+	oneOf(v, "shape", c.Shape, ConnectorShapeCurved, ConnectorShapeStraight, ConnectorShapeElbowed)
+	return v.result("SetConnector")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (d DocumentItemSet) Validate() error {
+	v := &validator{}
+	v.position("position", d.Position)
+	v.geometry("geometry", d.Geometry.Width, d.Geometry.Height)
+	return v.result("DocumentItemSet")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (u UploadFileItem) Validate() error {
+	v := &validator{}
+	v.position("position", u.Position)
+	v.geometry("geometry", u.Geometry.Width, u.Geometry.Height)
+	return v.result("UploadFileItem")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (e SetEmbedItem) Validate() error {
+	v := &validator{}
+	oneOf(v, "data.mode", e.Data.Mode, ModeInline, ModeModal)
+	v.position("position", e.Position)
+	v.geometry("geometry", e.Geometry.Width, e.Geometry.Height)
+	return v.result("SetEmbedItem")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (f SetFrameItem) Validate() error {
+	v := &validator{}
+	oneOf(v, "data.format", f.Data.Format, FormatCustom)
+	oneOf(v, "data.type", f.Data.Type, TypeFreeform)
+	v.style("style", f.Style)
+	v.position("position", f.Position)
+	v.geometry("geometry", f.Geometry.Width, f.Geometry.Height)
+	return v.result("SetFrameItem")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (i ImageItemSet) Validate() error {
+	v := &validator{}
+	v.position("position", i.Position)
+	v.geometry("geometry", i.Geometry.Width, i.Geometry.Height)
+	return v.result("ImageItemSet")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p OEmbedParams) Validate() error {
+	v := &validator{}
+	oneOf(v, "format", p.Format, OEmbedFormatJSON, OEmbedFormatXML)
+	v.positive("maxwidth", float64(p.MaxWidth))
+	v.positive("maxheight", float64(p.MaxHeight))
+	return v.result("OEmbedParams")
+}
+
+var basicShapes = []Shape{ShapeRectangle, ShapeRoundRectangle, ShapeCircle, ShapeTriangle, ShapeRhombus,
+	ShapeParallelogram, ShapeTrapezoid, ShapePentagon, ShapeHexagon, ShapeOctagon, ShapeWedgeRoundRectangleCallout,
+	ShapeStar, ShapeFlowChartPredefinedProcess, ShapeCloud, ShapeCross, ShapeCan, ShapeRightArrow, ShapeLeftArrow,
+	ShapeLeftRightArrow, ShapeLeftBrace, ShapeRightBrace}
+
+var flowChartShapes = []Shape{ShapeFlowChartProcess, ShapeFlowChartDecision, ShapeFlowChartTerminator,
+	ShapeFlowChartDocument, ShapeFlowChartMultiDocuments, ShapeFlowChartInputOutput, ShapeFlowChartPredefinedProcess2,
+	ShapeFlowChartPreparation, ShapeFlowChartManualInput, ShapeFlowChartManualOperation, ShapeFlowChartConnector,
+	ShapeFlowChartOffpageConnector, ShapeFlowChartDelay, ShapeFlowChartDisplay, ShapeFlowChartMerge, ShapeFlowChartOr,
+	ShapeFlowChartSummingJunction, ShapeFlowChartInternalStorage, ShapeFlowChartMagneticDisk,
+	ShapeFlowChartMagneticDrum, ShapeFlowChartOnlineStorage, ShapeFlowChartNoteCurlyLeft, ShapeFlowChartNoteCurlyRight,
+	ShapeFlowChartNoteSquare}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API. Flowchart shapes are
+// rejected, as only the experimental version of the API supports them; ShapeItemsService accepts them when it uses
+// that version, see WithAPIVersion.
+func (s SetShapeItem) Validate() error {
+	return s.validateFor(APIVersionV2)
+}
+
+// validateFor Validate against the constraints of the given version of the MIRO API
+func (s SetShapeItem) validateFor(version APIVersion) error {
+	v := &validator{}
+	if version == APIVersionV2Experimental {
+		oneOf(v, "data.shape", s.Data.Shape, append(basicShapes[:len(basicShapes):len(basicShapes)], flowChartShapes...)...)
+	} else if isFlowChartShape(s.Data.Shape) {
+		v.addf("data.shape", "%q is only supported by the %s API, see WithAPIVersion", s.Data.Shape, APIVersionV2Experimental)
+	} else {
+		oneOf(v, "data.shape", s.Data.Shape, basicShapes...)
+	}
+	v.style("style", s.Style)
+	v.position("position", s.Position)
+	v.geometry("geometry", s.Geometry.Width, s.Geometry.Height)
+	return v.result("SetShapeItem")
+}
+
+func isFlowChartShape(shape Shape) bool {
+	for _, s := range flowChartShapes {
+		if shape == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API. The limit of 8 tags
+// per item isn't checked, as the payload doesn't carry tags; it is checked by TagsService.Attach instead.
+func (s StickyNoteSet) Validate() error {
+	v := &validator{}
+	oneOf(v, "data.shape", s.Data.NoteShape, NoteShapeSquare, NoteShapeRectangle)
+	oneOf(v, "style.fillColor", s.Style.FillColor, NoteColorGray, NoteColorLightYellow, NoteColorYellow,
+		NoteColorOrange, NoteColorLightGreen, NoteColorGreen, NoteColorDarkGreen, NoteColorCyan, NoteColorLightPink,
+		NoteColorPink, NoteColorViolet, NoteColorRed, NoteColorLightBlue, NoteColorBlue, NoteColorDarkBlue,
+		NoteColorBlack)
+	oneOf(v, "style.textAlign", s.Style.TextAlign, textAligns...)
+	oneOf(v, "style.textAlignVertical", s.Style.TextAlignVertical, textAlignsVertical...)
+	v.position("position", s.Position)
+	v.geometry("geometry", s.Geometry.Width, s.Geometry.Height)
+	return v.result("StickyNoteSet")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API. The title isn't
+// required, as the same payload is used to update a tag.
+func (t TagSet) Validate() error {
+	v := &validator{}
+	v.maxLength("title", t.Title, 120)
+	oneOf(v, "fillColor", t.FillColor, TagColorRed, TagColorLightGreen, TagColorCyan, TagColorYellow,
+		TagColorMagenta, TagColorGreen, TagColorBlue, TagColorGray, TagColorViolet, TagColorDarkGreen, TagColorDarkBlue,
+		TagColorBlack)
+	return v.result("TagSet")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p TagSearchParams) Validate() error {
+	v := &validator{}
+	v.limit(p.Limit, p.Offset, 1, 50)
+	return v.result("TagSearchParams")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (t TextItemSet) Validate() error {
+	v := &validator{}
+	v.style("style", t.Style)
+	v.position("position", t.Position)
+	v.positive("geometry.width", t.Geometry.Width)
+	return v.result("TextItemSet")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (g GroupSet) Validate() error {
+	v := &validator{}
+	if n := len(g.Data.Items); n == 1 {
+		v.addf("data.items", "a group must contain at least 2 items, got %d", n)
+	}
+	return v.result("GroupSet")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p GroupSearchParams) Validate() error {
+	v := &validator{}
+	v.number("limit", p.Limit, "", 10, 50)
+	return v.result("GroupSearchParams")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (m MindmapNodeSet) Validate() error {
+	v := &validator{}
+	if m.Position != nil {
+		v.position("position", *m.Position)
+	}
+	if m.Geometry != nil {
+		v.geometry("geometry", m.Geometry.Width, m.Geometry.Height)
+	}
+	return v.result("MindmapNodeSet")
+}
+
+// Validate checks the search params against the constraints documented by the MIRO API
+func (p MindmapNodeSearchParams) Validate() error {
+	v := &validator{}
+	v.number("limit", p.Limit, "", 10, 50)
+	return v.result("MindmapNodeSearchParams")
+}
+
+// Validate checks the required fields are set, and the fields that are set against the constraints documented by the
+// MIRO API
+func (b BoardSubscriptionSet) Validate() error {
+	v := &validator{}
+	if b.BoardID == "" {
+		v.addf("boardId", "is required")
+	}
+	if b.CallbackURL == "" {
+		v.addf("callbackUrl", "is required")
+	}
+	v.callbackURL("callbackUrl", b.CallbackURL)
+	oneOf(v, "status", b.Status, SubscriptionStatusEnabled, SubscriptionStatusDisabled)
+	return v.result("BoardSubscriptionSet")
+}
+
+// Validate checks the fields that are set against the constraints documented by the MIRO API
+func (b BoardSubscriptionUpdate) Validate() error {
+	v := &validator{}
+	v.callbackURL("callbackUrl", b.CallbackURL)
+	oneOf(v, "status", b.Status, SubscriptionStatusEnabled, SubscriptionStatusDisabled)
+	return v.result("BoardSubscriptionUpdate")
+}
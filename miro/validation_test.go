@@ -0,0 +1,135 @@
+package miro
+
+import (
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	Convey("Given a payload that breaks several documented constraints", t, func() {
+		payload := SetShapeItem{
+			Data:  ShapeItemData{Shape: "blob"},
+			Style: Style{FillColor: "red", FontSize: "9", BorderOpacity: "1.5"},
+		}
+
+		Convey("When it is validated", func() {
+			err := payload.Validate()
+
+			Convey("Then every field error is returned, addressed by its JSON path", func() {
+				var validationErr *ValidationError
+				So(errors.As(err, &validationErr), ShouldBeTrue)
+				So(validationErr.Type, ShouldEqual, "SetShapeItem")
+
+				fields := make([]string, len(validationErr.Errors))
+				for i, fieldErr := range validationErr.Errors {
+					fields[i] = fieldErr.Field
+				}
+				So(fields, ShouldResemble, []string{"data.shape", "style.borderOpacity", "style.fillColor", "style.fontSize"})
+				So(err.Error(), ShouldContainSubstring, `style.fontSize: must be between 10 and 288, got 9`)
+				So(err.Error(), ShouldContainSubstring, `style.fillColor: "red" is not a hex colour`)
+			})
+		})
+	})
+
+	Convey("Given a partial payload with only valid fields set", t, func() {
+		payload := StickyNoteSet{Style: StickyNoteStyle{FillColor: NoteColorCyan}, Position: PositionSet{X: 10}}
+
+		Convey("When it is validated", func() {
+			err := payload.Validate()
+
+			Convey("Then it is valid", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a flowchart shape", t, func() {
+		payload := SetShapeItem{Data: ShapeItemData{Shape: ShapeFlowChartTerminator}}
+
+		Convey("When it is validated", func() {
+			err := payload.Validate()
+
+			Convey("Then it is rejected, as only the experimental API supports it", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "only supported by the v2-experimental API")
+			})
+		})
+
+		Convey("When it is validated for the experimental API", func() {
+			err := payload.validateFor(APIVersionV2Experimental)
+
+			Convey("Then it is valid", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a webhook subscription without its required fields", t, func() {
+		payload := BoardSubscriptionSet{Status: SubscriptionStatusEnabled}
+
+		Convey("When it is validated", func() {
+			err := payload.Validate()
+
+			Convey("Then each missing field is reported", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "invalid BoardSubscriptionSet: boardId: is required; callbackUrl: is required")
+			})
+		})
+	})
+
+	Convey("Given search params with a limit outside the range of the endpoint", t, func() {
+		params := ItemSearchParams{Limit: "5"}
+
+		Convey("When they are validated", func() {
+			err := params.Validate()
+
+			Convey("Then the limit is reported", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "invalid ItemSearchParams: limit: must be between 10 and 50, got 5")
+			})
+		})
+	})
+}
+
+func TestWithValidation(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2", endpointBoards, testBoardID, "sticky_notes")
+	defer closeAPIServer()
+	WithValidation()(client)
+
+	requests := 0
+	mux.HandleFunc(testResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "` + testItemID + `"}`))
+	})
+	mux.HandleFunc(testResourcePath+"/"+testItemID, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id": "` + testItemID + `"}`))
+	})
+
+	Convey("Given a client that validates payloads", t, func() {
+		requests = 0
+
+		Convey("When an invalid payload is sent", func() {
+			_, err := client.StickyNotes.Create(testBoardID, StickyNoteSet{Style: StickyNoteStyle{FillColor: "purple"}})
+
+			Convey("Then the validation error is returned without sending the request", func() {
+				var validationErr *ValidationError
+				So(errors.As(err, &validationErr), ShouldBeTrue)
+				So(validationErr.Errors[0].Field, ShouldEqual, "style.fillColor")
+				So(requests, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a valid partial update is sent", func() {
+			_, err := client.StickyNotes.Update(testBoardID, testItemID, StickyNoteSet{Position: PositionSet{X: 10}})
+
+			Convey("Then the request is sent", func() {
+				So(err, ShouldBeNil)
+				So(requests, ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,137 @@
+package miro
+
+import "context"
+
+// WebhooksService the webhook subscriptions of the user, which send the events of a board (e.g. items created, updated
+// or deleted) to a callback URL. Webhooks are only available in the experimental version of the MIRO API, so the
+// endpoints & types may change.
+type WebhooksService struct {
+	client     *Client
+	apiVersion string
+	resource   string
+}
+
+// CreateBoardSubscription creates a subscription that sends the events of a board to the callback URL. The MIRO API
+// sends a challenge to the callback URL first, which it must respond to for the subscription to be created.
+// Required scope: boards:read | Rate limiting: Level 2
+func (w *WebhooksService) CreateBoardSubscription(payload BoardSubscriptionSet) (*WebhookSubscription, error) {
+	return w.CreateBoardSubscriptionWithContext(w.client.ctx, payload)
+}
+
+// CreateBoardSubscriptionWithContext CreateBoardSubscription using the given context, which can be used to cancel the
+// request or set a deadline.
+func (w *WebhooksService) CreateBoardSubscriptionWithContext(ctx context.Context, payload BoardSubscriptionSet) (*WebhookSubscription, error) {
+	response := &WebhookSubscription{}
+
+	if url, err := constructURL(w.client.BaseURL, w.apiVersion, w.resource, "board_subscriptions"); err != nil {
+		return response, err
+	} else {
+		err = w.client.Post(withRateLimitLevel(ctx, RateLimitLevel2), url, payload, response)
+		return response, err
+	}
+}
+
+// UpdateBoardSubscription updates the callback URL or status of a board subscription, e.g. Status: disabled to stop
+// sending events without deleting the subscription.
+// Required scope: boards:read | Rate limiting: Level 2
+func (w *WebhooksService) UpdateBoardSubscription(subscriptionID string, payload BoardSubscriptionUpdate, fields ...PatchField) (*WebhookSubscription, error) {
+	return w.UpdateBoardSubscriptionWithContext(w.client.ctx, subscriptionID, payload, fields...)
+}
+
+// UpdateBoardSubscriptionWithContext UpdateBoardSubscription using the given context, which can be used to cancel the
+// request or set a deadline.
+func (w *WebhooksService) UpdateBoardSubscriptionWithContext(ctx context.Context, subscriptionID string, payload BoardSubscriptionUpdate, fields ...PatchField) (*WebhookSubscription, error) {
+	response := &WebhookSubscription{}
+
+	if url, err := constructURL(w.client.BaseURL, w.apiVersion, w.resource, "board_subscriptions", subscriptionID); err != nil {
+		return response, err
+	} else {
+		err = w.client.Patch(withRateLimitLevel(ctx, RateLimitLevel2), url, newPatch(payload, fields), response)
+		return response, err
+	}
+}
+
+// Get a webhook subscription.
+// Required scope: boards:read | Rate limiting: Level 4
+func (w *WebhooksService) Get(subscriptionID string) (*WebhookSubscription, error) {
+	return w.GetWithContext(w.client.ctx, subscriptionID)
+}
+
+// GetWithContext Get using the given context, which can be used to cancel the request or set a deadline.
+func (w *WebhooksService) GetWithContext(ctx context.Context, subscriptionID string) (*WebhookSubscription, error) {
+	response := &WebhookSubscription{}
+
+	if url, err := constructURL(w.client.BaseURL, w.apiVersion, w.resource, "subscriptions", subscriptionID); err != nil {
+		return response, err
+	} else {
+		err = w.client.Get(withRateLimitLevel(ctx, RateLimitLevel4), url, response)
+		return response, err
+	}
+}
+
+// GetAll webhook subscriptions of the user. This method returns results using a cursor-based approach, see
+// ItemsService.GetAll.
+// Required scope: boards:read | Rate limiting: Level 4
+// Search query params: WebhookSearchParams{}
+func (w *WebhooksService) GetAll(queryParams ...WebhookSearchParams) (*ListWebhookSubscriptions, error) {
+	return w.GetAllWithContext(w.client.ctx, queryParams...)
+}
+
+// GetAllWithContext GetAll using the given context, which can be used to cancel the request or set a deadline.
+func (w *WebhooksService) GetAllWithContext(ctx context.Context, queryParams ...WebhookSearchParams) (*ListWebhookSubscriptions, error) {
+	response := &ListWebhookSubscriptions{}
+
+	if url, err := constructURL(w.client.BaseURL, w.apiVersion, w.resource, "subscriptions"); err != nil {
+		return response, err
+	} else {
+		var searchParams []Parameter
+		if len(queryParams) > 0 {
+			if searchParams, err = encodeQueryTags(queryParams[0]); err != nil {
+				return response, err
+			}
+		}
+		err = w.client.Get(withRateLimitLevel(ctx, RateLimitLevel4), url, response, searchParams...)
+
+		return response, err
+	}
+}
+
+// Iterate returns an iterator over all the webhook subscriptions of the user, fetching the pages of results as they are
+// needed. The Cursor of the search params is used as the starting point.
+// Required scope: boards:read | Rate limiting: Level 4 (per page)
+func (w *WebhooksService) Iterate(queryParams ...WebhookSearchParams) *Iterator[*WebhookSubscription] {
+	return w.IterateWithContext(w.client.ctx, queryParams...)
+}
+
+// IterateWithContext Iterate using the given context, which can be used to cancel the fetching of pages or set a deadline.
+func (w *WebhooksService) IterateWithContext(ctx context.Context, queryParams ...WebhookSearchParams) *Iterator[*WebhookSubscription] {
+	var params WebhookSearchParams
+	if len(queryParams) > 0 {
+		params = queryParams[0]
+	}
+
+	iter := newIterator(ctx, func(ctx context.Context, cursor string) ([]*WebhookSubscription, string, error) {
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+		response, err := w.GetAllWithContext(ctx, params)
+		return response.Data, response.Cursor, err
+	})
+	iter.next = params.Cursor
+	return iter
+}
+
+// Delete a webhook subscription, no more events are sent to its callback URL.
+// Required scope: boards:read | Rate limiting: Level 2
+func (w *WebhooksService) Delete(subscriptionID string) error {
+	return w.DeleteWithContext(w.client.ctx, subscriptionID)
+}
+
+// DeleteWithContext Delete using the given context, which can be used to cancel the request or set a deadline.
+func (w *WebhooksService) DeleteWithContext(ctx context.Context, subscriptionID string) error {
+	if url, err := constructURL(w.client.BaseURL, w.apiVersion, w.resource, "subscriptions", subscriptionID); err != nil {
+		return err
+	} else {
+		return w.client.Delete(withRateLimitLevel(ctx, RateLimitLevel2), url)
+	}
+}
@@ -0,0 +1,162 @@
+package miro
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const testSubscriptionID = "3458764532456021893"
+
+func TestCreateBoardSubscription(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2-experimental", "webhooks", "", "")
+	defer closeAPIServer()
+
+	expectedResults := &WebhookSubscription{}
+	responseData := constructResponseAndResults("webhooks_get.json", expectedResults)
+
+	Convey("Given a board ID and a callback URL", t, func() {
+		Convey("When CreateBoardSubscription is called", func() {
+			var receivedRequest *http.Request
+			var body string
+			mux.HandleFunc(testResourcePath+"/board_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				body = string(data)
+				w.WriteHeader(http.StatusCreated)
+				w.Write(responseData)
+				receivedRequest = r
+			})
+
+			results, err := client.Webhooks.CreateBoardSubscription(BoardSubscriptionSet{
+				BoardID:     "uXjVOD6LSME=",
+				CallbackURL: "https://example.com/miro/events",
+			})
+
+			Convey("Then the subscription is created", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+				So(results.Status, ShouldEqual, SubscriptionStatusEnabled)
+
+				Convey("And the request contains the expected headers and parameters", func() {
+					So(receivedRequest, ShouldNotBeNil)
+					So(receivedRequest.Method, ShouldEqual, http.MethodPost)
+					So(receivedRequest.Header.Get("Authorization"), ShouldEqual, fmt.Sprintf("Bearer %s", testToken))
+					So(receivedRequest.URL.Path, ShouldEqual, "/v2-experimental/webhooks/board_subscriptions")
+					So(body, ShouldEqual, `{"boardId":"uXjVOD6LSME=","callbackUrl":"https://example.com/miro/events"}`)
+				})
+			})
+		})
+	})
+}
+
+func TestUpdateBoardSubscription(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2-experimental", "webhooks", "", "")
+	defer closeAPIServer()
+
+	expectedResults := &WebhookSubscription{}
+	responseData := constructResponseAndResults("webhooks_get.json", expectedResults)
+
+	Convey("Given a subscription ID", t, func() {
+		Convey("When the subscription is disabled", func() {
+			var receivedRequest *http.Request
+			var body string
+			mux.HandleFunc(testResourcePath+"/board_subscriptions/"+testSubscriptionID, func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				body = string(data)
+				w.Write(responseData)
+				receivedRequest = r
+			})
+
+			results, err := client.Webhooks.UpdateBoardSubscription(testSubscriptionID, BoardSubscriptionUpdate{Status: SubscriptionStatusDisabled})
+
+			Convey("Then only the status is sent", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedResults)
+				So(receivedRequest, ShouldNotBeNil)
+				So(receivedRequest.Method, ShouldEqual, http.MethodPatch)
+				So(body, ShouldEqual, `{"status":"disabled"}`)
+			})
+		})
+	})
+}
+
+func TestGetWebhookSubscriptions(t *testing.T) {
+	client, testResourcePath, mux, closeAPIServer := mockMIROAPI("v2-experimental", "webhooks", "", "")
+	defer closeAPIServer()
+
+	expectedSubscription := &WebhookSubscription{}
+	subscriptionData := constructResponseAndResults("webhooks_get.json", expectedSubscription)
+	expectedList := &ListWebhookSubscriptions{}
+	listData := constructResponseAndResults("webhooks_get_all.json", expectedList)
+
+	var receivedRequest *http.Request
+	mux.HandleFunc(testResourcePath+"/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(listData)
+		receivedRequest = r
+	})
+	mux.HandleFunc(testResourcePath+"/subscriptions/"+testSubscriptionID, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Write(subscriptionData)
+		}
+		receivedRequest = r
+	})
+	mux.HandleFunc(testResourcePath+"/subscriptions/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status": 404, "code": "notFound", "message": "Subscription not found", "type": "error"}`))
+	})
+
+	Convey("Given a subscription ID", t, func() {
+		Convey("When the Get method is called", func() {
+			results, err := client.Webhooks.Get(testSubscriptionID)
+
+			Convey("Then the subscription is returned", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedSubscription)
+				So(receivedRequest.URL.Path, ShouldEqual, testResourcePath+"/subscriptions/"+testSubscriptionID)
+			})
+		})
+
+		Convey("When the Delete method is called", func() {
+			err := client.Webhooks.Delete(testSubscriptionID)
+
+			Convey("Then the subscription is deleted (no error is returned)", func() {
+				So(err, ShouldBeNil)
+				So(receivedRequest.Method, ShouldEqual, http.MethodDelete)
+			})
+		})
+
+		Convey("When the subscription doesn't exist", func() {
+			_, err := client.Webhooks.Get("missing")
+
+			Convey("Then a not found error is returned", func() {
+				So(IsNotFound(err), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given WebhookSearchParams", t, func() {
+		Convey("When the GetAll method is called", func() {
+			results, err := client.Webhooks.GetAll(WebhookSearchParams{Limit: "10"})
+
+			Convey("Then the subscriptions are returned", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, expectedList)
+				So(receivedRequest.URL.Query().Get("limit"), ShouldEqual, "10")
+			})
+		})
+
+		Convey("When the subscriptions are iterated over", func() {
+			subscriptions, err := client.Webhooks.Iterate().All()
+
+			Convey("Then every subscription is returned", func() {
+				So(err, ShouldBeNil)
+				So(subscriptions, ShouldResemble, expectedList.Data)
+			})
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package miro
+
+import "time"
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusEnabled  SubscriptionStatus = "enabled"
+	SubscriptionStatusDisabled SubscriptionStatus = "disabled"
+	// SubscriptionStatusLostAccess the user who created the subscription lost access to the board
+	SubscriptionStatusLostAccess SubscriptionStatus = "lost_access"
+)
+
+type WebhookSubscriptionData struct {
+	// BoardID Unique identifier (ID) of the board the subscription is for.
+	BoardID string `json:"boardId"`
+	Extra   Extra  `json:"-"`
+}
+
+func (w *WebhookSubscriptionData) UnmarshalJSON(data []byte) error {
+	type webhookSubscriptionData WebhookSubscriptionData
+	return unmarshalWithExtra(data, (*webhookSubscriptionData)(w), &w.Extra)
+}
+
+func (w WebhookSubscriptionData) MarshalJSON() ([]byte, error) {
+	type webhookSubscriptionData WebhookSubscriptionData
+	return marshalWithExtra(webhookSubscriptionData(w), w.Extra)
+}
+
+type WebhookSubscription struct {
+	ID string `json:"id"`
+	// Type Type of the subscription, e.g. board_subscription.
+	Type string                  `json:"type"`
+	Data WebhookSubscriptionData `json:"data"`
+	// CallbackURL The URL the MIRO API sends the events to.
+	CallbackURL string             `json:"callbackUrl"`
+	Status      SubscriptionStatus `json:"status"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	ModifiedAt  time.Time          `json:"modifiedAt"`
+	Links       Links              `json:"links"`
+	Extra       Extra              `json:"-"`
+}
+
+func (w *WebhookSubscription) UnmarshalJSON(data []byte) error {
+	type webhookSubscription WebhookSubscription
+	return unmarshalWithExtra(data, (*webhookSubscription)(w), &w.Extra)
+}
+
+func (w WebhookSubscription) MarshalJSON() ([]byte, error) {
+	type webhookSubscription WebhookSubscription
+	return marshalWithExtra(webhookSubscription(w), w.Extra)
+}
+
+type BoardSubscriptionSet struct {
+	// BoardID Unique identifier (ID) of the board to receive the events of. (required)
+	BoardID string `json:"boardId"`
+	// CallbackURL The HTTPS URL to send the events to. The URL must respond to the challenge the MIRO API sends when the
+	// subscription is created. (required)
+	CallbackURL string `json:"callbackUrl"`
+	// Status Whether events are sent to the callback URL. Default: enabled.
+	Status SubscriptionStatus `json:"status,omitempty"`
+}
+
+type BoardSubscriptionUpdate struct {
+	// CallbackURL The HTTPS URL to send the events to.
+	CallbackURL string `json:"callbackUrl,omitempty"`
+	// Status Whether events are sent to the callback URL, set to disabled to pause the subscription.
+	Status SubscriptionStatus `json:"status,omitempty"`
+}
+
+type ListWebhookSubscriptions struct {
+	Data   []*WebhookSubscription `json:"data"`
+	Total  int                    `json:"total"`
+	Size   int                    `json:"size"`
+	Cursor string                 `json:"cursor,omitempty"`
+	Limit  int                    `json:"limit"`
+	Links  PaginationLinks        `json:"links"`
+	Type   string                 `json:"type"`
+	Extra  Extra                  `json:"-"`
+}
+
+func (l *ListWebhookSubscriptions) UnmarshalJSON(data []byte) error {
+	type listWebhookSubscriptions ListWebhookSubscriptions
+	return unmarshalWithExtra(data, (*listWebhookSubscriptions)(l), &l.Extra)
+}
+
+func (l ListWebhookSubscriptions) MarshalJSON() ([]byte, error) {
+	type listWebhookSubscriptions ListWebhookSubscriptions
+	return marshalWithExtra(listWebhookSubscriptions(l), l.Extra)
+}
+
+type WebhookSearchParams struct {
+	// Limit The maximum number of results to return per call. If the number of subscriptions in the response is greater
+	// than the limit specified, the response returns the cursor parameter with a value.
+	// Default: 10.
+	Limit string `query:"limit,omitempty"`
+	// Cursor A cursor-paginated method returns a portion of the total set of results based on the limit specified and a
+	// cursor that points to the next portion of the results. To retrieve the next portion of the collection, set the
+	// cursor parameter equal to the cursor value you received in the response of the previous request.
+	Cursor string `query:"cursor,omitempty"`
+}